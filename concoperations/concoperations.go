@@ -1,3 +1,12 @@
+// Package concoperations provides the matrix and vector operations of seqoperations computed
+// concurrently on a worker pool shared by the whole package.
+//
+// Matrix operations whose cost grows with the size of their input have a ...Context variant that stops
+// handing out work and returns ctx.Err() once the context is done. The exceptions are the constructors,
+// copies and conversions (NewZeroMatrix, NewMatrixFromSlice, FillMatrix, Copy, Float64Copy,
+// SequentialTranspose, SubMatrix, VectorFromColumn, Dense, Dense.Matrix and Dense.Copy) and the methods
+// of Vector. Each of these makes a single pass over memory the caller already holds or is about to hold,
+// so it finishes in about the time taken to allocate that memory and cancelling it would save little.
 package concoperations
 
 import (
@@ -16,9 +25,14 @@ var (
 	errDifferentDimension      = e.ErrDifferentDimension
 	errMultiplicationValidity  = e.ErrMultiplicationValidity
 	errNonSquare               = e.ErrNonSquare
+	errNoConvergence           = e.ErrNoConvergence
 	errNoInverse               = e.ErrNoInverse
+	errNormOrder               = e.ErrNormOrder
 	errNotFloat64              = e.ErrNotFloat64
+	errNotThreeDimensional     = e.ErrNotThreeDimensional
+	errRagged                  = e.ErrRagged
 	errRowColSuppliedOutBounds = e.ErrRowColSuppliedOutBounds
 	errUnexpected              = e.ErrUnexpected
 	errZeroLength              = e.ErrZeroLength
+	errZeroNorm                = e.ErrZeroNorm
 )
@@ -0,0 +1,234 @@
+package concoperations_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func cancelledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
+/*
+Test Context variants return ctx.Err() once cancelled
+*/
+
+func TestContextVariantsCancelled(t *testing.T) {
+	ctx := cancelledContext()
+	m := concoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}
+
+	_, err := m.MapFunctionToElementsContext(ctx, func(element int) int { return element })
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.ApplyOneToOneContext(ctx, m, concoperations.Add[int])
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.MultiplyContext(ctx, m)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.InverseContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.DeterminantContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, _, _, err = m.LUContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.SolveContext(ctx, concoperations.Vector[int]{1, 2, 3})
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.SolveMatrixContext(ctx, m)
+	assert.Equal(t, context.Canceled, err)
+
+	_, _, err = m.RowEchelonContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, _, err = m.ReducedRowEchelonContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.RankContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.NullSpaceContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.MulVecContext(ctx, concoperations.Vector[int]{1, 2, 3})
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.VecMulContext(ctx, concoperations.Vector[int]{1, 2, 3})
+	assert.Equal(t, context.Canceled, err)
+
+	d, err := m.Dense()
+	assert.Nil(t, err)
+	_, err = d.MultiplyContext(ctx, d)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestReductionContextVariantsCancelled(t *testing.T) {
+	ctx := cancelledContext()
+	m := concoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}
+
+	_, err := m.ReduceContext(ctx, concoperations.Add[int], 0)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.SumContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = concoperations.Matrix[float64]{{1.5}}.SumContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.MinContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.MaxContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, _, err = m.ArgMaxContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.MeanContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, _, err = m.MeanStandardDevContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.FrobeniusNormContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.Norm1Context(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.NormInfContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+
+	_, err = m.SpectralNormContext(ctx)
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestContextVariantsMatchPlainVariants(t *testing.T) {
+	ctx := context.Background()
+	m := concoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}
+
+	product, err := m.MultiplyContext(ctx, m)
+	assert.Nil(t, err)
+	expectedProduct, _ := m.Multiply(m)
+	assert.Equal(t, expectedProduct, product)
+
+	inv, err := m.InverseContext(ctx)
+	assert.Nil(t, err)
+	expectedInv, _ := m.Inverse()
+	assert.Equal(t, expectedInv, inv)
+
+	det, err := m.DeterminantContext(ctx)
+	assert.Nil(t, err)
+	assert.InDelta(t, -3.0, det, 1e-12)
+
+	reduced, pivots, err := m.ReducedRowEchelonContext(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 2}, pivots)
+	assert.True(t, concoperations.NewIdentityMatrix[float64](3).WithinSigma(reduced, 1e-12))
+
+	rank, err := m.RankContext(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, rank)
+
+	v := concoperations.Vector[int]{1, -1, 2}
+	mv, err := m.MulVecContext(ctx, v)
+	assert.Nil(t, err)
+	expectedMv, _ := m.MulVec(v)
+	assert.Equal(t, expectedMv, mv)
+
+	vm, err := m.VecMulContext(ctx, v)
+	assert.Nil(t, err)
+	expectedVm, _ := m.VecMul(v)
+	assert.Equal(t, expectedVm, vm)
+
+	sum, err := m.SumContext(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 46, sum)
+
+	max, err := m.MaxContext(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, 10, max)
+
+	mean, stdev, err := m.MeanStandardDevContext(ctx)
+	assert.Nil(t, err)
+	expectedMean, expectedStdev, _ := m.MeanStandardDev()
+	assert.Equal(t, expectedMean, mean)
+	assert.Equal(t, expectedStdev, stdev)
+
+	norm, err := m.SpectralNormContext(ctx)
+	assert.Nil(t, err)
+	expectedNorm, _ := m.SpectralNorm()
+	assert.Equal(t, expectedNorm, norm)
+}
+
+func TestReductionContextVariantsReportEmptyAndRaggedInput(t *testing.T) {
+	ctx := context.Background()
+	for _, input := range []struct {
+		m   concoperations.Matrix[int]
+		err error
+	}{
+		{concoperations.Matrix[int]{}, e.ErrZeroLength},
+		{concoperations.Matrix[int]{{1, 2}, {3}}, e.ErrRagged},
+	} {
+		_, err := input.m.MinContext(ctx)
+		assert.Equal(t, input.err, err)
+		_, err = input.m.MaxContext(ctx)
+		assert.Equal(t, input.err, err)
+		_, _, err = input.m.ArgMaxContext(ctx)
+		assert.Equal(t, input.err, err)
+		_, err = input.m.MeanContext(ctx)
+		assert.Equal(t, input.err, err)
+		_, _, err = input.m.MeanStandardDevContext(ctx)
+		assert.Equal(t, input.err, err)
+	}
+}
+
+func TestContextErrorsPrecedence(t *testing.T) {
+	// argument errors are still reported for a live context
+	_, err := concoperations.Matrix[int]{{1, 2}}.MultiplyContext(context.Background(), concoperations.Matrix[int]{{1, 2}})
+	assert.NotNil(t, err)
+	assert.NotEqual(t, context.Canceled, err)
+}
+
+func TestMultiplyContextDeadline(t *testing.T) {
+	// a deadline that has already passed is reported without depending on how long the work takes
+	m := concoperations.NewConstantMatrix(400, 400, 1.5)
+	ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	_, err := m.MultiplyContext(ctx, m)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestContextCancelledDuringWork(t *testing.T) {
+	withProcs(t, 4, func() {
+		m := concoperations.NewConstantMatrix(1000, 100, 1)
+
+		// the operator cancels the context on its first call, while the work is in progress
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		_, err := m.MapFunctionToElementsContext(ctx, func(element int) int {
+			cancel()
+			return element
+		})
+		assert.Equal(t, context.Canceled, err)
+
+		ctx, cancel = context.WithCancel(context.Background())
+		defer cancel()
+		_, err = m.ApplyOneToOneContext(ctx, m, func(x, y int) int {
+			cancel()
+			return x + y
+		})
+		assert.Equal(t, context.Canceled, err)
+	})
+}
@@ -1,9 +1,5 @@
 package concoperations
 
-import (
-	"sync"
-)
-
 // packages
 
 // matrices, vectors, operations
@@ -15,19 +11,12 @@ func NewZeroMatrix[N Number](i, j int) Matrix[N] {
 		return Matrix[N]{}
 	}
 
-	var wg sync.WaitGroup
-	wg.Add(i)
 	m := make(Matrix[N], i)
-
-	for row := 0; row < i; row++ {
-		r := row
-		go func() {
-			defer wg.Done()
-			line := make([]N, j)
-			m[r] = line
-		}()
-	}
-	wg.Wait()
+	parallelRows(i, j, func(start, end int) {
+		for row := start; row < end; row++ {
+			m[row] = make([]N, j)
+		}
+	})
 
 	return m
 }
@@ -40,19 +29,14 @@ func NewMatrixFromSlice[N Number](i, j int, input []N) Matrix[N] {
 		return Matrix[N]{}
 	}
 	m := NewZeroMatrix[N](i, j)
-	product := i * j
 	inputLength := len(input)
-	var wg sync.WaitGroup
-	wg.Add(product)
-	for g := 0; g < product; g++ {
-		k := g
-		go func() {
-			defer wg.Done()
-			iPos, jPos, kPos := k/j, k%j, k%inputLength
-			m[iPos][jPos] = input[kPos]
-		}()
-	}
-	wg.Wait()
+	parallelRows(i, j, func(start, end int) {
+		for iPos := start; iPos < end; iPos++ {
+			for jPos := 0; jPos < j; jPos++ {
+				m[iPos][jPos] = input[(iPos*j+jPos)%inputLength]
+			}
+		}
+	})
 	return m
 }
 
@@ -63,16 +47,9 @@ func NewIdentityMatrix[N Number](dimension int) Matrix[N] {
 	}
 	m := NewZeroMatrix[N](dimension, dimension)
 	one := N(1)
-	var wg sync.WaitGroup
-	wg.Add(dimension)
-	for d := 0; d < dimension; d++ {
-		k := d
-		go func() {
-			defer wg.Done()
-			m[k][k] = one
-		}()
+	for k := 0; k < dimension; k++ {
+		m[k][k] = one
 	}
-	wg.Wait()
 	return m
 }
 
@@ -86,16 +63,30 @@ func NewConstantMatrix[N Number](iDim, jDim int, x N) Matrix[N] {
 // FillMatrix fills a matrix in order that every element is the supplied constant
 func (m Matrix[N]) FillMatrix(x N) {
 	rows, columns := m.Dimensions()
-	var wg sync.WaitGroup
-	wg.Add(rows * columns)
-	for i := 0; i < rows; i++ {
-		for j := 0; j < columns; j++ {
-			a, b := i, j
-			go func() {
-				defer wg.Done()
-				m[a][b] = x
-			}()
+	parallelRows(rows, columns, func(start, end int) {
+		for i := start; i < end; i++ {
+			for j := 0; j < columns; j++ {
+				m[i][j] = x
+			}
 		}
+	})
+}
+
+// AsRowMatrix returns a 1 x len(v) matrix holding a copy of v
+func (v Vector[N]) AsRowMatrix() Matrix[N] {
+	row := make([]N, len(v))
+	copy(row, v)
+	return Matrix[N]{row}
+}
+
+// AsColumnMatrix returns a len(v) x 1 matrix holding a copy of v.
+// The rows share a single allocation rather than each being allocated separately.
+func (v Vector[N]) AsColumnMatrix() Matrix[N] {
+	column := make([]N, len(v))
+	copy(column, v)
+	m := make(Matrix[N], len(v))
+	for i := range m {
+		m[i] = column[i : i+1 : i+1]
 	}
-	wg.Wait()
+	return m
 }
@@ -1,7 +1,5 @@
 package concoperations
 
-import "sync"
-
 // NewZeroVector returns a vector of specified length.
 func NewZeroVector[N Number](length int) Vector[N] {
 	v := make(Vector[N], length)
@@ -15,16 +13,11 @@ func (m Matrix[N]) VectorFromColumn(column int) (Vector[N], bool) {
 		return Vector[N]{}, false
 	}
 	v := NewZeroVector[N](i)
-	var wg sync.WaitGroup
-	wg.Add(i)
-	for k := 0; k < i; k++ {
-		d := k
-		go func() {
-			defer wg.Done()
-			v[d] = m[d][column]
-		}()
-	}
-	wg.Wait()
+	parallelRange(i, minChunkElements, func(start, end int) {
+		for k := start; k < end; k++ {
+			v[k] = m[k][column]
+		}
+	})
 	return v, true
 }
 
@@ -0,0 +1,55 @@
+package concoperations_test
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test CSV import and export agree with seqoperations
+*/
+
+func TestWriteCSVMatchesSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+	m := concoperations.NewMatrixFromSlice(40, 3, randomFloatVector(120, r))
+	for _, options := range [][]concoperations.CSVOption{
+		nil,
+		{concoperations.WithColumnNames("a", "b", "c")},
+		{concoperations.WithDelimiter(';'), concoperations.WithColumnNames("a", "b;c", "d")},
+		{concoperations.WithColumnNames("a")},
+		{concoperations.WithDelimiter('\n')},
+	} {
+		var c, s bytes.Buffer
+		cErr := m.WriteCSV(&c, options...)
+		sErr := seqoperations.Matrix[float64](m).WriteCSV(&s, options...)
+		assert.Equal(t, sErr, cErr)
+		assert.Equal(t, s.String(), c.String())
+	}
+
+	var c, s bytes.Buffer
+	cErr := concoperations.Matrix[int]{{1}, {2, 3}}.WriteCSV(&c)
+	sErr := seqoperations.Matrix[int]{{1}, {2, 3}}.WriteCSV(&s)
+	assert.NotNil(t, cErr)
+	assert.Equal(t, sErr, cErr)
+}
+
+func TestReadCSVMatchesSequential(t *testing.T) {
+	for _, input := range []string{"", "1\t2\n3\t4\n", "1\t2\n3\tx\n", "1\n2\t3\n", "300\t1\n", "a\tb\n1\t2\n"} {
+		for _, options := range [][]concoperations.CSVOption{
+			{concoperations.WithDelimiter('\t')},
+			{concoperations.WithDelimiter('\t'), concoperations.WithHeader()},
+			{concoperations.WithDelimiter('"')},
+		} {
+			c, cErr := concoperations.ReadCSV[uint8](strings.NewReader(input), options...)
+			s, sErr := seqoperations.ReadCSV[uint8](strings.NewReader(input), options...)
+			assert.Equal(t, sErr, cErr)
+			assert.Equal(t, s, seqoperations.Matrix[uint8](c))
+		}
+	}
+}
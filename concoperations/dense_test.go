@@ -0,0 +1,81 @@
+package concoperations_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test Dense agrees with seqoperations
+*/
+
+func TestDenseMatchesSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	withProcs(t, 8, func() {
+		for _, shape := range [][3]int{{1, 1, 1}, {7, 5, 6}, {150, 90, 120}} {
+			a, b := randomMatrix(shape[0], shape[1], r), randomMatrix(shape[1], shape[2], r)
+
+			aDense, err := a.Dense()
+			assert.Nil(t, err)
+			bDense, err := b.Dense()
+			assert.Nil(t, err)
+			assert.Equal(t, a, aDense.Matrix())
+			assert.Equal(t, a, aDense.Copy().Matrix())
+
+			sA, _ := seqoperations.Matrix[int](a).Dense()
+			sB, _ := seqoperations.Matrix[int](b).Dense()
+			expected, err := sA.Multiply(sB)
+			assert.Nil(t, err)
+			product, err := aDense.Multiply(bDense)
+			assert.Nil(t, err)
+			assert.Equal(t, expected.Matrix(), seqoperations.Matrix[int](product.Matrix()))
+
+			// views of a larger matrix multiply through their stride
+			view, err := aDense.View(shape[0]/2, 0, shape[0], shape[1])
+			assert.Nil(t, err)
+			fromView, err := view.Multiply(bDense)
+			assert.Nil(t, err)
+			assert.Equal(t, expected.Matrix()[shape[0]/2:], seqoperations.Matrix[int](fromView.Matrix()))
+			assert.Equal(t, shape[1], view.Stride())
+		}
+	})
+}
+
+func TestDenseZeroWidthShapeMatchesSequential(t *testing.T) {
+	d, err := concoperations.Matrix[int]{{}, {}}.Dense()
+	assert.Nil(t, err)
+	sD, _ := seqoperations.Matrix[int]{{}, {}}.Dense()
+	rows, columns := d.Dimensions()
+	sRows, sColumns := sD.Dimensions()
+	assert.Equal(t, sRows, rows)
+	assert.Equal(t, sColumns, columns)
+	assert.Equal(t, concoperations.Matrix[int]{{}, {}}, d.Copy().Matrix())
+
+	product, err := concoperations.NewDense[int](2, 0).Multiply(concoperations.NewDense[int](0, 3))
+	assert.Nil(t, err)
+	assert.Equal(t, concoperations.NewZeroMatrix[int](2, 3), product.Matrix())
+}
+
+func TestDenseErrors(t *testing.T) {
+	for _, ragged := range []concoperations.Matrix[int]{{{1, 2}, {3}}, {{1}, {2, 3}}} {
+		_, err := ragged.Dense()
+		assert.Equal(t, e.ErrRagged, err)
+	}
+
+	d := concoperations.NewDense[int](2, 3)
+	_, err := d.Multiply(d)
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+	_, err = d.View(1, 1, 1, 2)
+	assert.Equal(t, e.ErrRowColSuppliedOutBounds, err)
+	_, ok := d.RowView(2)
+	assert.False(t, ok)
+	assert.Panics(t, func() { d.At(2, 0) })
+
+	_, err = concoperations.NewDenseFromSlice(2, 2, []int{1, 2, 3})
+	assert.Equal(t, e.ErrDifferentDimension, err)
+}
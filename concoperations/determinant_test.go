@@ -0,0 +1,52 @@
+package concoperations_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test Determinant agrees with seqoperations
+*/
+
+func TestDeterminantMatchesSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(6))
+	withProcs(t, 8, func() {
+		for _, size := range []int{1, 2, 3, 8, 40, 120} {
+			c := randomMatrix(size, size, r)
+			s := seqoperations.Matrix[int](c)
+
+			sDet, sErr := s.Determinant()
+			cDet, cErr := c.Determinant()
+			assert.Equal(t, sErr, cErr)
+			assert.InDelta(t, sDet, cDet, 1e-12*math.Abs(sDet))
+
+			sDet, sErr = s.Float64Copy().DeterminantAssumeFloat64Input()
+			cDet, cErr = concoperations.Matrix[float64](s.Float64Copy()).DeterminantAssumeFloat64Input()
+			assert.Equal(t, sErr, cErr)
+			assert.InDelta(t, sDet, cDet, 1e-12*math.Abs(sDet))
+		}
+	})
+}
+
+func TestDeterminantSingularMatchesSequential(t *testing.T) {
+	for _, m := range [][][]int{{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, {{0, 1}, {0, 2}}, {{0, 0}, {0, 0}}} {
+		sDet, sErr := seqoperations.Matrix[int](m).Determinant()
+		cDet, cErr := concoperations.Matrix[int](m).Determinant()
+		assert.Nil(t, cErr)
+		assert.Equal(t, sErr, cErr)
+		assert.InDelta(t, sDet, cDet, 1e-12)
+	}
+
+	for _, m := range [][][]int{{}, {{1, 2, 3}, {4, 5, 6}}} {
+		_, sErr := seqoperations.Matrix[int](m).Determinant()
+		_, cErr := concoperations.Matrix[int](m).Determinant()
+		assert.NotNil(t, cErr)
+		assert.Equal(t, sErr, cErr)
+	}
+}
@@ -0,0 +1,160 @@
+package concoperations
+
+import (
+	"context"
+	"math"
+)
+
+// RowEchelon returns a float64 copy of the matrix reduced to row echelon form by Gaussian elimination
+// with partial pivoting, along with the column index of the pivot in each non zero row.
+// Elements small enough relative to the largest element of the matrix to be rounding error are treated as zero.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) RowEchelon() (Matrix[float64], []int, error) {
+	return m.RowEchelonContext(context.Background())
+}
+
+// RowEchelonContext behaves as RowEchelon but stops between elimination steps
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) RowEchelonContext(ctx context.Context) (Matrix[float64], []int, error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, nil, err
+	}
+	matrix := m.float64Copy()
+	pivots, err := rowReduce(ctx, matrix, false)
+	if err != nil {
+		return Matrix[float64]{}, nil, err
+	}
+	return matrix, pivots, nil
+}
+
+// ReducedRowEchelon returns a float64 copy of the matrix reduced to reduced row echelon form,
+// where every pivot is one and is the only non zero element in its column, along with the pivot columns.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) ReducedRowEchelon() (Matrix[float64], []int, error) {
+	return m.ReducedRowEchelonContext(context.Background())
+}
+
+// ReducedRowEchelonContext behaves as ReducedRowEchelon but stops between elimination steps
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) ReducedRowEchelonContext(ctx context.Context) (Matrix[float64], []int, error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, nil, err
+	}
+	matrix := m.float64Copy()
+	pivots, err := rowReduce(ctx, matrix, true)
+	if err != nil {
+		return Matrix[float64]{}, nil, err
+	}
+	return matrix, pivots, nil
+}
+
+// Rank returns the rank of the matrix as the number of pivots in its row echelon form.
+// It matches seqoperations EchelonRank, as this package has no SVD based rank.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) Rank() (int, error) {
+	return m.RankContext(context.Background())
+}
+
+// RankContext behaves as Rank but stops between elimination steps
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) RankContext(ctx context.Context) (int, error) {
+	_, pivots, err := m.RowEchelonContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return len(pivots), nil
+}
+
+// NullSpace returns a matrix whose columns form a basis of the null space of the matrix,
+// i.e. vectors x with M * x = 0, derived from its reduced row echelon form.
+// For a matrix with j columns and rank r the result is j x (j - r).
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) NullSpace() (Matrix[float64], error) {
+	return m.NullSpaceContext(context.Background())
+}
+
+// NullSpaceContext behaves as NullSpace but stops between elimination steps
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) NullSpaceContext(ctx context.Context) (Matrix[float64], error) {
+	reduced, pivots, err := m.ReducedRowEchelonContext(ctx)
+	if err != nil {
+		return Matrix[float64]{}, err
+	}
+	_, columns := m.Dimensions()
+
+	isPivot := make([]bool, columns)
+	for _, column := range pivots {
+		isPivot[column] = true
+	}
+
+	basis := NewZeroMatrix[float64](columns, columns-len(pivots))
+	free := 0
+	for column := 0; column < columns; column++ {
+		if isPivot[column] {
+			continue
+		}
+		// set this free variable to one and solve for the pivot variables
+		basis[column][free] = 1.0
+		for row, pivotColumn := range pivots {
+			basis[pivotColumn][free] = -reduced[row][column]
+		}
+		free++
+	}
+	return basis, nil
+}
+
+// rowReduce carries out Gaussian elimination with partial pivoting on a in place and returns the pivot columns.
+// If reduced is true every pivot is scaled to one and eliminated from the rows above as well as below.
+// ctx.Err() is returned if ctx is done before elimination completes.
+func rowReduce(ctx context.Context, a Matrix[float64], reduced bool) ([]int, error) {
+	rows, columns := a.Dimensions()
+	tolerance := singularTolerance(a)
+	pivots := []int{}
+
+	row := 0
+	for column := 0; column < columns && row < rows; column++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		pivot := a.FindMaxPivot(row, column)
+		if pivot == -1 || math.Abs(a[pivot][column]) <= tolerance {
+			// nothing to pivot on, clear any rounding error left in the column
+			for i := row; i < rows; i++ {
+				a[i][column] = 0.0
+			}
+			continue
+		}
+		// rows are known to be in bounds so errors can be discarded
+		_ = a.SwapRows(row, pivot)
+		if reduced {
+			_ = a.RowScalarMultiply(row, 1.0/a[row][column])
+			a[row][column] = 1.0
+		}
+
+		start := row + 1
+		if reduced {
+			start = 0
+		}
+		// every other row depends only on the pivot row so rows are eliminated concurrently
+		p, c := row, column
+		err := parallelRowsContext(ctx, rows-start, columns-column, func(from, to int) {
+			for i := start + from; i < start+to; i++ {
+				if i == p || a[i][c] == 0.0 {
+					continue
+				}
+				factor := a[i][c] / a[p][c]
+				for j := c; j < columns; j++ {
+					a[i][j] -= factor * a[p][j]
+				}
+				a[i][c] = 0.0
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		pivots = append(pivots, column)
+		row++
+	}
+	return pivots, nil
+}
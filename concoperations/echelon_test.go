@@ -0,0 +1,65 @@
+package concoperations_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test echelon forms agree with seqoperations
+*/
+
+func TestEchelonMatchesSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(9))
+	withProcs(t, 8, func() {
+		for _, shape := range [][3]int{{3, 3, 2}, {7, 4, 4}, {4, 9, 4}, {150, 120, 5}, {120, 150, 120}} {
+			// the product of random rows x rank and rank x columns matrices has the given rank
+			left, right := randomMatrix(shape[0], shape[2], r), randomMatrix(shape[2], shape[1], r)
+			c, err := left.Multiply(right)
+			assert.Nil(t, err)
+			s := seqoperations.Matrix[int](c)
+
+			sEchelon, sPivots, err := s.RowEchelon()
+			assert.Nil(t, err)
+			cEchelon, cPivots, err := c.RowEchelon()
+			assert.Nil(t, err)
+			assert.Equal(t, sPivots, cPivots)
+			assertMatchesSequential(t, sEchelon, cEchelon, 1e-9)
+
+			sReduced, sPivots, err := s.ReducedRowEchelon()
+			assert.Nil(t, err)
+			cReduced, cPivots, err := c.ReducedRowEchelon()
+			assert.Nil(t, err)
+			assert.Equal(t, sPivots, cPivots)
+			assertMatchesSequential(t, sReduced, cReduced, 1e-9)
+
+			rank, err := c.Rank()
+			assert.Nil(t, err)
+			assert.Equal(t, shape[2], rank)
+			sRank, err := s.EchelonRank()
+			assert.Nil(t, err)
+			assert.Equal(t, sRank, rank)
+
+			sNull, err := s.NullSpace()
+			assert.Nil(t, err)
+			cNull, err := c.NullSpace()
+			assert.Nil(t, err)
+			assertMatchesSequential(t, sNull, cNull, 1e-9)
+		}
+	})
+}
+
+func TestEchelonOfEmptyMatchesSequential(t *testing.T) {
+	for _, m := range [][][]int{{}, {{}, {}}, {{0, 0}, {0, 0}}} {
+		sReduced, sPivots, err := seqoperations.Matrix[int](m).ReducedRowEchelon()
+		assert.Nil(t, err)
+		cReduced, cPivots, err := concoperations.Matrix[int](m).ReducedRowEchelon()
+		assert.Nil(t, err)
+		assert.Equal(t, sPivots, cPivots)
+		assert.Equal(t, sReduced, seqoperations.Matrix[float64](cReduced))
+	}
+}
@@ -0,0 +1,79 @@
+package concoperations_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertMatchesSequential checks that a concurrent float64 result is within delta of the sequential one
+func assertMatchesSequential(t *testing.T, expected seqoperations.Matrix[float64], actual concoperations.Matrix[float64], delta float64) {
+	t.Helper()
+	assert.True(t, expected.WithinSigma(seqoperations.Matrix[float64](actual), delta), "expected %v\nactual %v", expected, actual)
+}
+
+/*
+Test LU agrees with seqoperations
+*/
+
+func TestLUMatchesSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	withProcs(t, 8, func() {
+		for _, size := range []int{2, 5, 33, 150} {
+			c := randomMatrix(size, size, r)
+			s := seqoperations.Matrix[int](c)
+
+			sL, sU, sPerm, err := s.LU()
+			assert.Nil(t, err)
+			cL, cU, cPerm, err := c.LU()
+			assert.Nil(t, err)
+			assert.Equal(t, sPerm, cPerm)
+			assertMatchesSequential(t, sL, cL, 1e-12)
+			assertMatchesSequential(t, sU, cU, 1e-9)
+		}
+	})
+}
+
+func TestLUErrorsMatchSequential(t *testing.T) {
+	for _, m := range [][][]int{{}, {{1, 2, 3}, {4, 5, 6}}, {{1, 2}, {2, 4}}, {{0, 0}, {0, 0}}} {
+		_, _, _, sErr := seqoperations.Matrix[int](m).LU()
+		_, _, _, cErr := concoperations.Matrix[int](m).LU()
+		assert.NotNil(t, cErr)
+		assert.Equal(t, sErr, cErr)
+	}
+
+	_, _, _, err := concoperations.Matrix[int]{{1, 2}, {2, 4}}.LU()
+	assert.Equal(t, e.ErrNoInverse, err)
+}
+
+func TestBadlyScaledMatrixMatchesSequential(t *testing.T) {
+	// every pivot is far below the largest element but large relative to its own row
+	m := [][]float64{{1e10, 0}, {0, 1e-10}}
+
+	sL, sU, sPerm, err := seqoperations.Matrix[float64](m).LU()
+	assert.Nil(t, err)
+	cL, cU, cPerm, err := concoperations.Matrix[float64](m).LU()
+	assert.Nil(t, err)
+	assert.Equal(t, sPerm, cPerm)
+	assert.Equal(t, sL, seqoperations.Matrix[float64](cL))
+	assert.Equal(t, sU, seqoperations.Matrix[float64](cU))
+
+	sInv, err := seqoperations.Matrix[float64](m).Inverse()
+	assert.Nil(t, err)
+	cInv, err := concoperations.Matrix[float64](m).Inverse()
+	assert.Nil(t, err)
+	assert.Equal(t, sInv, seqoperations.Matrix[float64](cInv))
+
+	sX, err := seqoperations.Matrix[float64](m).Solve([]float64{1e10, 1e-10})
+	assert.Nil(t, err)
+	cX, err := concoperations.Matrix[float64](m).Solve([]float64{1e10, 1e-10})
+	assert.Nil(t, err)
+	assert.Equal(t, []float64(sX), []float64(cX))
+
+	_, err = concoperations.Matrix[float64](m).SolveMatrix([][]float64{{1}, {1}})
+	assert.Nil(t, err)
+}
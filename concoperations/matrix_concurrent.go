@@ -1,8 +1,8 @@
 package concoperations
 
 import (
+	"context"
 	"math"
-	"sync"
 )
 
 // Dimensions returns the dimensions of a supplied matrix.
@@ -21,47 +21,76 @@ func (m Matrix[N]) IsSquare() bool {
 	return rows == columns
 }
 
+// Validate returns errRagged if the rows of m are not all the same length.
+// Dimensions inspects only the first row, so operations check their input with Validate
+// before indexing into it.
+func (m Matrix[N]) Validate() error {
+	for i := 1; i < len(m); i++ {
+		if len(m[i]) != len(m[0]) {
+			return errRagged
+		}
+	}
+	return nil
+}
+
+// validate returns errRagged if any of the supplied matrices is ragged
+func validate[N Number](matrices ...Matrix[N]) error {
+	for _, m := range matrices {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // MapFunctionToElements takes fn: a function that returns a result of operation on one element of matrix m,
-// x: a second argument for fn and returns new matrix with same operation applied to every element
+// x: a second argument for fn and returns new matrix with same operation applied to every element.
+// An empty matrix is returned if m is ragged.
 func (m Matrix[N]) MapFunctionToElements(fn ConstantSequentialOperater[N]) Matrix[N] {
+	output, _ := m.MapFunctionToElementsContext(context.Background(), fn)
+	return output
+}
+
+// MapFunctionToElementsContext behaves as MapFunctionToElements but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) MapFunctionToElementsContext(ctx context.Context, fn ConstantSequentialOperater[N]) (Matrix[N], error) {
 
+	if err := m.Validate(); err != nil {
+		return Matrix[N]{}, err
+	}
 	rows, columns := m.Dimensions()
 	output := NewZeroMatrix[N](rows, columns)
 
-	var wg sync.WaitGroup
-	wg.Add(rows * columns)
-
-	for i := 0; i < rows; i++ {
-		for j := 0; j < columns; j++ {
-			a, b := i, j
-			go func() {
-				defer wg.Done()
-				output[a][b] = fn(m[a][b])
-			}()
+	err := parallelRowsContext(ctx, rows, columns, func(start, end int) {
+		for i := start; i < end; i++ {
+			for j := 0; j < columns; j++ {
+				output[i][j] = fn(m[i][j])
+			}
 		}
+	})
+	if err != nil {
+		return Matrix[N]{}, err
 	}
-	wg.Wait()
 
-	return output
+	return output, nil
 }
 
 // MapFunctionToElementsInRowInPlace takes a function to be applied to every element of a row
 // and a row number and then applies the function in place
 func (m Matrix[N]) MapFunctionToElementsInRowInPlace(fn ConstantSequentialOperater[N], row int) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
 	rows, cols := m.Dimensions()
 	if row >= rows || row < 0 {
 		return errRowColSuppliedOutBounds
 	}
-	var wg sync.WaitGroup
-	wg.Add(cols)
-	for j := 0; j < cols; j++ {
-		k := j
-		go func() {
-			defer wg.Done()
-			m[row][k] = fn(m[row][k])
-		}()
-	}
-	wg.Wait()
+	parallelRange(cols, minChunkElements, func(start, end int) {
+		for j := start; j < end; j++ {
+			m[row][j] = fn(m[row][j])
+		}
+	})
 	return nil
 }
 
@@ -74,24 +103,31 @@ func (m Matrix[N]) RowScalarMultiply(row int, multiplier N) error {
 // takes fn: a function that is applied element wise to each element Mij and Nij.
 // in the resulting matrix : Pij = fn(Mij, Nij)
 func (m Matrix[N]) ApplyOneToOne(n Matrix[N], fn OneToOneSequentialOperater[N]) (Matrix[N], error) {
+	return m.ApplyOneToOneContext(context.Background(), n, fn)
+}
+
+// ApplyOneToOneContext behaves as ApplyOneToOne but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) ApplyOneToOneContext(ctx context.Context, n Matrix[N], fn OneToOneSequentialOperater[N]) (Matrix[N], error) {
+	if err := validate(m, n); err != nil {
+		return nil, err
+	}
 	rows, columns := m.Dimensions()
 	rowsCheck, columnsCheck := n.Dimensions()
 	if (rows != rowsCheck) || (columns != columnsCheck) {
 		return nil, errDifferentDimension
 	}
 	p := NewZeroMatrix[N](rows, columns)
-	var wg sync.WaitGroup
-	wg.Add(rows * columns)
-	for i := 0; i < rows; i++ {
-		for j := 0; j < columns; j++ {
-			a, b := i, j
-			go func() {
-				defer wg.Done()
-				p[a][b] = fn(m[a][b], n[a][b])
-			}()
+	err := parallelRowsContext(ctx, rows, columns, func(start, end int) {
+		for i := start; i < end; i++ {
+			for j := 0; j < columns; j++ {
+				p[i][j] = fn(m[i][j], n[i][j])
+			}
 		}
+	})
+	if err != nil {
+		return nil, err
 	}
-	wg.Wait()
 	return p, nil
 }
 
@@ -163,78 +199,176 @@ func (m Matrix[N]) ElementWiseDivide(n Matrix[N]) (Matrix[N], error) {
 	return out, nil
 }
 
-// Mean returns float64 mean of matrix m if m contains elements
+// Mean returns float64 mean of matrix m if m contains elements and is not ragged.
+// Elements are summed concurrently with compensated summation.
 func (m Matrix[N]) Mean() (float64, bool) {
+	mean, err := m.MeanContext(context.Background())
+	return mean, err == nil
+}
 
-	rows, columns := m.Dimensions()
-	var total float64
-	if rows == 0 || columns == 0 {
-		return total, false
+// MeanContext behaves as Mean but reports an empty matrix with errZeroLength and a ragged one with errRagged.
+// It stops scheduling work and returns ctx.Err() if ctx is cancelled or its deadline passes.
+func (m Matrix[N]) MeanContext(ctx context.Context) (float64, error) {
+	if err := m.validateNonEmpty(); err != nil {
+		return 0.0, err
 	}
-
-	mCopy := m.Float64Copy()
-	for i := 0; i < rows; i++ {
-		for j := 0; j < columns; j++ {
-			total += mCopy[i][j]
-		}
+	rows, columns := m.Dimensions()
+	total, err := m.sumFloat64(ctx, func(element N) float64 { return float64(element) })
+	if err != nil {
+		return 0.0, err
 	}
-
-	return total / (float64(rows) * float64(columns)), true
+	return total / (float64(rows) * float64(columns)), nil
 }
 
 // MeanStandardDev returns the mean and standard deviation of
-// a matrix if it contains elements
+// a matrix if it contains elements and is not ragged.
+// The mean and the sum of squared distances from it are each found with a concurrent compensated sum.
 func (m Matrix[N]) MeanStandardDev() (float64, float64, bool) {
+	mean, stdev, err := m.MeanStandardDevContext(context.Background())
+	return mean, stdev, err == nil
+}
 
-	rows, columns := m.Dimensions()
-	if rows == 0 || columns == 0 {
-		return 0.0, 0.0, false
-	}
+// MeanStandardDevContext behaves as MeanStandardDev but reports an empty matrix with errZeroLength
+// and a ragged one with errRagged.
+// It stops scheduling work and returns ctx.Err() if ctx is cancelled or its deadline passes.
+func (m Matrix[N]) MeanStandardDevContext(ctx context.Context) (float64, float64, error) {
 
-	// instantiate total to sum elements in matrix
-	var total float64
-	mCopy := m.Float64Copy()
-	for i := 0; i < rows; i++ {
-		for j := 0; j < columns; j++ {
-			total += mCopy[i][j]
-		}
+	mean, err := m.MeanContext(ctx)
+	if err != nil {
+		return 0.0, 0.0, err
 	}
-	rowsTimesColumns := float64(rows) * float64(columns)
-	mean := total / rowsTimesColumns
 
-	// reinstantiate total to 0.0 as it will now be used to some square of dist from mean
-	total = 0.0
-	for i := 0; i < rows; i++ {
-		for j := 0; j < columns; j++ {
-			diff := mCopy[i][j] - mean
-			diffSquared := diff * diff
-			total += diffSquared
-		}
+	rows, columns := m.Dimensions()
+	total, err := m.sumFloat64(ctx, func(element N) float64 {
+		diff := float64(element) - mean
+		return diff * diff
+	})
+	if err != nil {
+		return 0.0, 0.0, err
 	}
-	variance := total / ((rowsTimesColumns) - 1.0)
+	variance := total / ((float64(rows) * float64(columns)) - 1.0)
 	stdev := math.Sqrt(variance)
 
-	return mean, stdev, true
+	return mean, stdev, nil
 }
 
-// Multiply returns matrix P = M * N if multiplication is valid
-func (m Matrix[N]) Multiply(n Matrix[N]) (Matrix[N], error) {
+// Multiply returns matrix P = M * N if multiplication is valid.
+// Bands of rows of P are computed concurrently against a transposed copy of N; products needing
+// fewer multiplications than the sequential threshold are computed on the calling goroutine.
+// Parallelism and threshold may be adjusted with WithParallelism and WithSequentialThreshold.
+func (m Matrix[N]) Multiply(n Matrix[N], options ...MultiplyOption) (Matrix[N], error) {
+	return m.MultiplyContext(context.Background(), n, options...)
+}
+
+// MultiplyContext behaves as Multiply but stops between rows of the result
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) MultiplyContext(ctx context.Context, n Matrix[N], options ...MultiplyOption) (Matrix[N], error) {
 
+	if err := validate(m, n); err != nil {
+		return nil, err
+	}
 	rows, columns, ok := m.MultiplicationDimensions(n)
 	if !ok {
 		return nil, errMultiplicationValidity
 	}
+	settings := newMultiplySettings(options)
+
+	inner := len(n)
+	parallelism := settings.parallelism
+	if rows*columns*inner < settings.sequentialThreshold {
+		parallelism = 1
+	}
 
+	nT := n.transpose()
 	out := NewZeroMatrix[N](rows, columns)
-	for i := 0; i < rows; i++ {
-		// Validity of multiplication handled at start of method, booleans from vector ops are discarded
-		rowVector, _ := m.VectorFromRow(i)
-		for j := 0; j < columns; j++ {
-			columnVector, _ := n.VectorFromColumn(j)
-			out[i][j], _ = rowVector.DotProduct(columnVector)
+	err := parallelRangeLimit(ctx, rows, 1, parallelism, func(start, end int) {
+		for i := start; i < end; i++ {
+			if ctx.Err() != nil {
+				return
+			}
+			rowVector, outRow := m[i], out[i]
+			for j := 0; j < columns; j++ {
+				columnVector := nT[j]
+				var total N
+				for k, element := range rowVector {
+					total += element * columnVector[k]
+				}
+				outRow[j] = total
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// MulVec returns the vector M * v, treating v as a column.
+// Each element is the dot product of a row of M with v, so no intermediate matrices are built,
+// and chunks of rows are computed concurrently.
+// errMultiplicationValidity is returned if the length of v does not match the columns of M.
+func (m Matrix[N]) MulVec(v Vector[N]) (Vector[N], error) {
+	return m.MulVecContext(context.Background(), v)
+}
+
+// MulVecContext behaves as MulVec but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) MulVecContext(ctx context.Context, v Vector[N]) (Vector[N], error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	rows, columns := m.Dimensions()
+	if len(v) != columns {
+		return nil, errMultiplicationValidity
+	}
+	out := NewZeroVector[N](rows)
+	err := parallelRowsContext(ctx, rows, columns, func(start, end int) {
+		for i := start; i < end; i++ {
+			var total N
+			for j, element := range m[i] {
+				total += element * v[j]
+			}
+			out[i] = total
 		}
+	})
+	if err != nil {
+		return nil, err
 	}
+	return out, nil
+}
+
+// VecMul returns the vector v * M, treating v as a row.
+// Each worker takes a chunk of columns and accumulates the matching part of every row of M,
+// scaled by the elements of v, so no worker writes to another's elements.
+// errMultiplicationValidity is returned if the length of v does not match the rows of M.
+func (m Matrix[N]) VecMul(v Vector[N]) (Vector[N], error) {
+	return m.VecMulContext(context.Background(), v)
+}
 
+// VecMulContext behaves as VecMul but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) VecMulContext(ctx context.Context, v Vector[N]) (Vector[N], error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	rows, columns := m.Dimensions()
+	if len(v) != rows {
+		return nil, errMultiplicationValidity
+	}
+	out := NewZeroVector[N](columns)
+	err := parallelRangeContext(ctx, columns, rowGrain(rows), func(start, end int) {
+		outChunk := out[start:end]
+		for i, row := range m {
+			scale := v[i]
+			for j, element := range row[start:end] {
+				outChunk[j] += scale * element
+			}
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
 	return out, nil
 }
 
@@ -242,54 +376,76 @@ func (m Matrix[N]) Inverse() (Matrix[float64], error) {
 	return m.InverseAssumeAnyTypeInput()
 }
 
+// InverseContext behaves as Inverse but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) InverseContext(ctx context.Context) (Matrix[float64], error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, err
+	}
+	matrix := m.float64Copy()
+	return matrix.inverseAssumeFloat64Input(ctx)
+}
+
 // InverseAssumeAnyTypeInput returns a float64 matrix representing the inverse of the supplied matrix.
 func (m Matrix[N]) InverseAssumeAnyTypeInput() (Matrix[float64], error) {
-	matrix := m.Float64Copy()
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, err
+	}
+	matrix := m.float64Copy()
 	return matrix.InverseAssumeFloat64Input()
 }
 
 // InverseAssumeFloat64Input returns a float64 matrix representing the inverse of the supplied matrix.
 // The method requires a float64 matrix to operate.
+// The inverse is found from an LU decomposition with partial pivoting and the supplied matrix is not modified.
 func (m Matrix[N]) InverseAssumeFloat64Input() (Matrix[N], error) {
+	return m.inverseAssumeFloat64Input(context.Background())
+}
 
-	det, err := m.DeterminantAssumeFloat64Input() // this will catch N != float64
+// inverseAssumeFloat64Input carries out InverseAssumeFloat64Input, stopping if ctx is done
+func (m Matrix[N]) inverseAssumeFloat64Input(ctx context.Context) (Matrix[N], error) {
 
-	if (err == errZeroLength) || (err == errNonSquare) || (err == errNotFloat64) {
+	checkType := N(0)
+	isAssumedInputType := IsFloat64(checkType)
+	if !isAssumedInputType {
+		return Matrix[N]{}, errNotFloat64
+	}
+	// N must be type float64 if this line is reached
+	if err := m.Validate(); err != nil {
+		return Matrix[N]{}, err
+	}
+	matrix := any(m.clone()).(Matrix[float64])
+
+	lu, perm, err := luFactoriseNonSingular(ctx, matrix)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return Matrix[N]{}, ctxErr
+	}
+	if (err == errZeroLength) || (err == errNonSquare) || (err == errNoInverse) {
 		return Matrix[N]{}, err
 	}
 	if err != nil {
 		return Matrix[N]{}, errUnexpected
 	}
-	if det == 0 {
-		return Matrix[N]{}, errNoInverse
-	}
 
-	// instantiate inverse matrix
-	dimension := len(m)
-	inverseMatrix := NewIdentityMatrix[N](dimension)
-
-	// Apply Gauss-Jordan elimination to find the inverse
-	for i := 0; i < dimension; i++ {
-		// Divide row i by matrix[i][i]
-		factor := 1.0 / m[i][i]
-		for j := 0; j < dimension; j++ {
-			m[i][j] *= factor
-			inverseMatrix[i][j] *= factor
-		}
-
-		// Subtract row i from all other rows to make matrix[i][i] equal to 1
-		for j := 0; j < dimension; j++ {
-			if j != i {
-				factor := m[j][i]
-				for k := 0; k < dimension; k++ {
-					m[j][k] -= factor * m[i][k]
-					inverseMatrix[j][k] -= factor * inverseMatrix[i][k]
-				}
+	// solve for each column of the identity matrix, columns are independent so are solved concurrently
+	dimension := len(lu)
+	inverseMatrix := NewZeroMatrix[float64](dimension, dimension)
+	err = parallelRangeContext(ctx, dimension, rowGrain(dimension*dimension), func(start, end int) {
+		unit := make([]float64, dimension)
+		for j := start; j < end; j++ {
+			unit[j] = 1.0
+			column := luSolve(lu, perm, unit)
+			for i := 0; i < dimension; i++ {
+				inverseMatrix[i][j] = column[i]
 			}
+			unit[j] = 0.0
 		}
+	})
+	if err != nil {
+		return Matrix[N]{}, err
 	}
 
-	return inverseMatrix, nil
+	return any(inverseMatrix).(Matrix[N]), nil
 }
 
 // Determinant returns the determinant of a matrix as a float64 value
@@ -297,10 +453,23 @@ func (m Matrix[N]) Determinant() (float64, error) {
 	return m.DeterminantAssumeAnyTypeInput()
 }
 
+// DeterminantContext behaves as Determinant but stops between elimination steps
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) DeterminantContext(ctx context.Context) (float64, error) {
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	matrix := m.float64Copy()
+	return matrix.determinantAssumeFloat64Input(ctx)
+}
+
 // DeterminantAssumeAnyTypeInput returns the determinant of a matrix of
 // any number type as a float64 value
 func (m Matrix[N]) DeterminantAssumeAnyTypeInput() (float64, error) {
-	matrix := m.Float64Copy()
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	matrix := m.float64Copy()
 	return matrix.DeterminantAssumeFloat64Input()
 }
 
@@ -309,6 +478,11 @@ func (m Matrix[N]) DeterminantAssumeAnyTypeInput() (float64, error) {
 // Although signature allows any type of Number output, type assertion carried out
 // in method assures that the Number output will be of type float64 if no error is returned.
 func (m Matrix[N]) DeterminantAssumeFloat64Input() (N, error) {
+	return m.determinantAssumeFloat64Input(context.Background())
+}
+
+// determinantAssumeFloat64Input carries out DeterminantAssumeFloat64Input, stopping if ctx is done
+func (m Matrix[N]) determinantAssumeFloat64Input(ctx context.Context) (N, error) {
 
 	checkType := N(0)
 	isAssumedInputType := IsFloat64(checkType)
@@ -316,6 +490,9 @@ func (m Matrix[N]) DeterminantAssumeFloat64Input() (N, error) {
 		return 0.0, errNotFloat64
 	}
 	// N must be type float64 if this line is reached
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
 
 	// return errors if determinant does not exist
 	isSquare := m.IsSquare()
@@ -328,35 +505,25 @@ func (m Matrix[N]) DeterminantAssumeFloat64Input() (N, error) {
 		return 0.0, errZeroLength
 	}
 
-	if n == 1 {
-		return m[0][0], nil
+	// reduce a copy to upper triangular form, the determinant is then the product of the
+	// diagonal, negated once for every row exchange made while pivoting
+	matrix := any(m.clone()).(Matrix[float64])
+	lu, _, sign, err := luFactorise(ctx, matrix)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return 0.0, ctxErr
 	}
-
-	if n == 2 {
-		return m[0][0]*m[1][1] - m[0][1]*m[1][0], nil
+	if err == errNoInverse {
+		return 0.0, nil
+	}
+	if err != nil {
+		return 0.0, errUnexpected
 	}
 
-	det := N(0)
+	det := sign
 	for i := 0; i < n; i++ {
-		submatrix := NewZeroMatrix[N](n-1, n-1)
-		for j := 1; j < n; j++ {
-			for k := 0; k < n; k++ {
-				if k < i {
-					submatrix[j-1][k] = m[j][k]
-				} else if k > i {
-					submatrix[j-1][k-1] = m[j][k]
-				}
-			}
-		}
-
-		sign := N(1)
-		if i%2 != 0 {
-			sign = -N(1)
-		}
-		subMatrixDeterminant, _ := submatrix.DeterminantAssumeFloat64Input()
-		det += sign * m[0][i] * subMatrixDeterminant
+		det *= lu[i][i]
 	}
-	return det, nil
+	return N(det), nil
 }
 
 // SwapRows swaps row1 and row 2 of matrix m in situ
@@ -382,55 +549,100 @@ func (m Matrix[N]) FindPivot(row, column int) int {
 	return -1
 }
 
+// FindMaxPivot returns the index of the row, at or below the supplied row, holding the
+// largest absolute value in the supplied column. -1 is returned if all such values are zero.
+func (m Matrix[N]) FindMaxPivot(row, column int) int {
+	rows, _ := m.Dimensions()
+	pivot, largest := -1, N(0)
+	for i := row; i < rows; i++ {
+		value := m[i][column]
+		if value < N(0) {
+			value = -value
+		}
+		if value > largest {
+			pivot, largest = i, value
+		}
+	}
+	return pivot
+}
+
 // SubMatrix returns a copied sub matrix
 func (m Matrix[N]) SubMatrix(rowMin, colMin, rowMax, colMax int) (Matrix[N], error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[N]{}, err
+	}
 	rows, columns := m.Dimensions()
 	if rowMin < 0 || rowMin > rowMax || rowMax >= rows || colMin < 0 || colMin > colMax || colMax >= columns {
 		return Matrix[N]{}, errRowColSuppliedOutBounds
 	}
 	submatrix := NewZeroMatrix[N](rows, columns)
-	var wg sync.WaitGroup
-
-	for i := rowMin; i < rowMax; i++ {
-		for j := colMin; j < colMax; j++ {
-			wg.Add(1)
-			a, b := i, j
-			go func() {
-				defer wg.Done()
-				submatrix[a-rowMin][b-colMin] = m[a][b]
-			}()
+	parallelRows(rowMax-rowMin, colMax-colMin, func(start, end int) {
+		for i := rowMin + start; i < rowMin+end; i++ {
+			for j := colMin; j < colMax; j++ {
+				submatrix[i-rowMin][j-colMin] = m[i][j]
+			}
 		}
-	}
-	wg.Wait()
+	})
 	return submatrix, nil
 }
 
-// Copy returns a copy of supplied matrix
+// Copy returns a copy of supplied matrix.
+// An empty matrix is returned if m is ragged.
 func (m Matrix[N]) Copy() Matrix[N] {
+	if m.Validate() != nil {
+		return Matrix[N]{}
+	}
+	return m.clone()
+}
+
+// clone returns a copy of m, which must not be ragged, copying chunks of rows concurrently
+func (m Matrix[N]) clone() Matrix[N] {
 	rows, columns := m.Dimensions()
 	copy := NewZeroMatrix[N](rows, columns)
-	for i := 0; i < rows; i++ {
-		for j := 0; j < rows; j++ {
-			copy[i][j] = m[i][j]
+	parallelRows(rows, columns, func(start, end int) {
+		for i := start; i < end; i++ {
+			for j := 0; j < columns; j++ {
+				copy[i][j] = m[i][j]
+			}
 		}
-	}
+	})
 	return copy
 }
 
-// Float64Copy returns a copy of the provided matrix with all Number N converted to float64
+// Float64Copy returns a copy of the provided matrix with all Number N converted to float64.
+// An empty matrix is returned if m is ragged.
 func (m Matrix[N]) Float64Copy() Matrix[float64] {
+	if m.Validate() != nil {
+		return Matrix[float64]{}
+	}
+	return m.float64Copy()
+}
+
+// float64Copy returns a copy of m with every element converted to float64, m must not be ragged
+func (m Matrix[N]) float64Copy() Matrix[float64] {
 	rows, columns := m.Dimensions()
 	copy := NewZeroMatrix[float64](rows, columns)
-	for i := 0; i < rows; i++ {
-		for j := 0; j < rows; j++ {
-			copy[i][j] = float64(m[i][j])
+	parallelRows(rows, columns, func(start, end int) {
+		for i := start; i < end; i++ {
+			for j := 0; j < columns; j++ {
+				copy[i][j] = float64(m[i][j])
+			}
 		}
-	}
+	})
 	return copy
 }
 
-// SequentialTranspose returns the transpose of a matrix
+// SequentialTranspose returns the transpose of a matrix.
+// An empty matrix is returned if m is ragged.
 func (m Matrix[N]) SequentialTranspose() Matrix[N] {
+	if m.Validate() != nil {
+		return Matrix[N]{}
+	}
+	return m.transpose()
+}
+
+// transpose returns the transpose of m, which must not be ragged
+func (m Matrix[N]) transpose() Matrix[N] {
 	x, y := m.Dimensions()
 	t := NewZeroMatrix[N](y, x)
 	entries := x * y
@@ -449,13 +661,13 @@ func (m Matrix[N]) SameDimensions(n Matrix[N]) bool {
 }
 
 // WithinSigma returns true if m and n have the same dimensions and for each element
-// sigma > | Mij - Nij |
+// sigma > | Mij - Nij |. False is returned if either matrix is ragged.
 func (m Matrix[N]) WithinSigma(n Matrix[N], sigma float64) bool {
 	sameDimensions := m.SameDimensions(n)
-	if !sameDimensions {
+	if !sameDimensions || validate(m, n) != nil {
 		return false
 	}
-	m64, n64 := m.Float64Copy(), n.Float64Copy()
+	m64, n64 := m.float64Copy(), n.float64Copy()
 	rows, columns := m.Dimensions()
 	for i := 0; i < rows; i++ {
 		for j := 0; j < columns; j++ {
@@ -0,0 +1,141 @@
+package concoperations_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+// These tests share matrices between goroutines so are intended to be run with -race
+
+func randomMatrix(rows, columns int, r *rand.Rand) concoperations.Matrix[int] {
+	m := concoperations.NewZeroMatrix[int](rows, columns)
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] = r.Intn(21) - 10
+		}
+	}
+	return m
+}
+
+/*
+Test Multiply
+*/
+
+func TestMultiplyMatchesSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	withProcs(t, 8, func() {
+		for _, shape := range [][3]int{{1, 1, 1}, {2, 3, 4}, {17, 1, 33}, {65, 64, 63}, {128, 40, 9}} {
+			a, b := randomMatrix(shape[0], shape[1], r), randomMatrix(shape[1], shape[2], r)
+			expected, _ := seqoperations.Matrix[int](a).Multiply(seqoperations.Matrix[int](b))
+
+			for _, parallelism := range []int{1, 2, 3, 8, 64} {
+				product, err := a.Multiply(b, concoperations.WithParallelism(parallelism), concoperations.WithSequentialThreshold(0))
+				assert.Nil(t, err)
+				assert.Equal(t, expected, seqoperations.Matrix[int](product))
+			}
+
+			product, err := a.Multiply(b)
+			assert.Nil(t, err)
+			assert.Equal(t, expected, seqoperations.Matrix[int](product))
+		}
+	})
+}
+
+func TestMultiplyConcurrentCallers(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	a, b := randomMatrix(90, 70, r), randomMatrix(70, 80, r)
+	expected, _ := seqoperations.Matrix[int](a).Multiply(seqoperations.Matrix[int](b))
+
+	withProcs(t, 4, func() {
+		results := make(chan concoperations.Matrix[int], 8)
+		for g := 0; g < cap(results); g++ {
+			go func() {
+				product, _ := a.Multiply(b, concoperations.WithSequentialThreshold(0))
+				results <- product
+			}()
+		}
+		for g := 0; g < cap(results); g++ {
+			assert.Equal(t, expected, seqoperations.Matrix[int](<-results))
+		}
+	})
+}
+
+func TestMultiplyEmptyAndInvalid(t *testing.T) {
+	product, err := concoperations.Matrix[int]{{}, {}}.Multiply(concoperations.Matrix[int]{})
+	assert.Nil(t, err)
+	assert.Equal(t, concoperations.Matrix[int]{{}, {}}, product)
+
+	_, err = concoperations.Matrix[int]{{1, 2}}.Multiply(concoperations.Matrix[int]{{1, 2}})
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+}
+
+func TestMulVecAndVecMulMatchSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(4))
+	withProcs(t, 8, func() {
+		for _, shape := range [][2]int{{1, 1}, {2, 3}, {300, 40}, {40, 300}, {129, 257}} {
+			m := randomMatrix(shape[0], shape[1], r)
+			v, _ := randomMatrix(shape[1], 1, r).VectorFromColumn(0)
+			u, _ := randomMatrix(1, shape[0], r).VectorFromRow(0)
+			s := seqoperations.Matrix[int](m)
+
+			expected, _ := s.MulVec(seqoperations.Vector[int](v))
+			product, err := m.MulVec(v)
+			assert.Nil(t, err)
+			assert.Equal(t, []int(expected), []int(product))
+
+			expected, _ = s.VecMul(seqoperations.Vector[int](u))
+			product, err = m.VecMul(u)
+			assert.Nil(t, err)
+			assert.Equal(t, []int(expected), []int(product))
+		}
+
+		m := randomMatrix(3, 2, r)
+		_, err := m.MulVec(concoperations.Vector[int]{1, 2, 3})
+		assert.Equal(t, e.ErrMultiplicationValidity, err)
+		_, err = m.VecMul(concoperations.Vector[int]{1, 2})
+		assert.Equal(t, e.ErrMultiplicationValidity, err)
+	})
+}
+
+func TestAsRowAndColumnMatrix(t *testing.T) {
+	v := concoperations.Vector[int]{1, 2, 3}
+	assert.Equal(t, concoperations.Matrix[int]{{1, 2, 3}}, v.AsRowMatrix())
+	assert.Equal(t, concoperations.Matrix[int]{{1}, {2}, {3}}, v.AsColumnMatrix())
+}
+
+func TestDotProduct(t *testing.T) {
+	total, ok := concoperations.Vector[int]{1, 2, 3}.DotProduct(concoperations.Vector[int]{4, 5, 6})
+	assert.True(t, ok)
+	assert.Equal(t, 32, total)
+
+	_, ok = concoperations.Vector[int]{1}.DotProduct(concoperations.Vector[int]{1, 2})
+	assert.False(t, ok)
+}
+
+/*
+Benchmark Multiply against seqoperations
+*/
+
+func BenchmarkMultiply(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	for _, size := range []int{64, 256, 512} {
+		x, y := randomMatrix(size, size, r).Float64Copy(), randomMatrix(size, size, r).Float64Copy()
+		sx, sy := seqoperations.Matrix[float64](x), seqoperations.Matrix[float64](y)
+		b.Run(fmt.Sprintf("concurrent/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = x.Multiply(y)
+			}
+		})
+		b.Run(fmt.Sprintf("sequential/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = sx.Multiply(sy)
+			}
+		})
+	}
+}
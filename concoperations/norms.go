@@ -0,0 +1,429 @@
+package concoperations
+
+import (
+	"context"
+	"math"
+)
+
+// Limits of the Jacobi iteration used by SpectralNorm, which stops once the off diagonal norm of the
+// Gram matrix is below spectralNormTolerance relative to its norm
+const (
+	spectralNormTolerance = 1e-15
+	spectralNormMaxSweeps = 100
+)
+
+// NormL1 returns the sum of the absolute values of the elements of v
+func (v Vector[N]) NormL1() float64 {
+	return sumRange(len(v), func(k int) float64 { return math.Abs(float64(v[k])) })
+}
+
+// NormL2 returns the Euclidean length of v.
+// Elements are scaled by the largest before squaring so that the result does not overflow or underflow.
+func (v Vector[N]) NormL2() float64 {
+	scale := v.NormInf()
+	if scale == 0.0 || math.IsInf(scale, 1) {
+		return scale
+	}
+	total := sumRange(len(v), func(k int) float64 {
+		x := float64(v[k]) / scale
+		return x * x
+	})
+	return scale * math.Sqrt(total)
+}
+
+// NormInf returns the largest absolute value of the elements of v, or zero for an empty vector
+func (v Vector[N]) NormInf() float64 {
+	return maxRange(len(v), func(k int) float64 { return math.Abs(float64(v[k])) })
+}
+
+// NormP returns the p-norm of v, (sum of |v_i|^p)^(1/p), for p >= 1.
+// A p of positive infinity gives NormInf. errNormOrder is returned if p is less than 1.
+func (v Vector[N]) NormP(p float64) (float64, error) {
+	switch {
+	case math.IsNaN(p) || p < 1.0:
+		return 0.0, errNormOrder
+	case p == 1.0:
+		return v.NormL1(), nil
+	case p == 2.0:
+		return v.NormL2(), nil
+	case math.IsInf(p, 1):
+		return v.NormInf(), nil
+	}
+	scale := v.NormInf()
+	if scale == 0.0 || math.IsInf(scale, 1) {
+		return scale, nil
+	}
+	total := sumRange(len(v), func(k int) float64 { return math.Pow(math.Abs(float64(v[k]))/scale, p) })
+	return scale * math.Pow(total, 1.0/p), nil
+}
+
+// EuclideanDistance returns the Euclidean length of v - u.
+// errDifferentDimension is returned if the vectors are not the same length.
+func (v Vector[N]) EuclideanDistance(u Vector[N]) (float64, error) {
+	difference, err := v.float64Difference(u)
+	if err != nil {
+		return 0.0, err
+	}
+	return difference.NormL2(), nil
+}
+
+// ManhattanDistance returns the sum of the absolute differences between elements of v and u.
+// errDifferentDimension is returned if the vectors are not the same length.
+func (v Vector[N]) ManhattanDistance(u Vector[N]) (float64, error) {
+	difference, err := v.float64Difference(u)
+	if err != nil {
+		return 0.0, err
+	}
+	return difference.NormL1(), nil
+}
+
+// CosineDistance returns 1 - cos(theta), where theta is the angle between v and u, which ranges from
+// 0 for vectors pointing the same way to 2 for vectors pointing in opposite directions.
+// errDifferentDimension is returned if the vectors are not the same length and errZeroNorm if either
+// vector is zero, as its direction is undefined.
+func (v Vector[N]) CosineDistance(u Vector[N]) (float64, error) {
+	difference, _, err := v.unitDistances(u)
+	if err != nil {
+		return 0.0, err
+	}
+	// 1 - cos(theta) is half the squared distance between the unit vectors, which keeps its
+	// precision for small angles where subtracting the cosine from one would not
+	return difference * difference / 2.0, nil
+}
+
+// FrobeniusNorm returns the square root of the sum of the squares of the elements of m.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) FrobeniusNorm() (float64, error) {
+	return m.FrobeniusNormContext(context.Background())
+}
+
+// FrobeniusNormContext behaves as FrobeniusNorm but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) FrobeniusNormContext(ctx context.Context) (float64, error) {
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	scale, err := m.maxAbs(ctx)
+	if err != nil || scale == 0.0 || math.IsInf(scale, 1) {
+		return scale, err
+	}
+	total, err := m.sumFloat64(ctx, func(element N) float64 {
+		x := float64(element) / scale
+		return x * x
+	})
+	if err != nil {
+		return 0.0, err
+	}
+	return scale * math.Sqrt(total), nil
+}
+
+// Norm1 returns the largest sum of the absolute values of the elements of a column of m.
+// Each chunk of rows contributes partial column sums, which are then added together.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) Norm1() (float64, error) {
+	return m.Norm1Context(context.Background())
+}
+
+// Norm1Context behaves as Norm1 but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) Norm1Context(ctx context.Context) (float64, error) {
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	rows, columns := m.Dimensions()
+	partials, err := reduceRowsContext(ctx, rows, columns, func(start, end int) []float64 {
+		sums := make([]float64, columns)
+		for i := start; i < end; i++ {
+			for j := 0; j < columns; j++ {
+				sums[j] += math.Abs(float64(m[i][j]))
+			}
+		}
+		return sums
+	})
+	if err != nil {
+		return 0.0, err
+	}
+	largest := 0.0
+	for j := 0; j < columns; j++ {
+		total := 0.0
+		for _, sums := range partials {
+			total += sums[j]
+		}
+		largest = math.Max(largest, total)
+	}
+	return largest, nil
+}
+
+// NormInf returns the largest sum of the absolute values of the elements of a row of m.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) NormInf() (float64, error) {
+	return m.NormInfContext(context.Background())
+}
+
+// NormInfContext behaves as NormInf but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) NormInfContext(ctx context.Context) (float64, error) {
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	rows, columns := m.Dimensions()
+	partials, err := reduceRowsContext(ctx, rows, columns, func(start, end int) float64 {
+		largest := 0.0
+		for i := start; i < end; i++ {
+			total := 0.0
+			for j := 0; j < columns; j++ {
+				total += math.Abs(float64(m[i][j]))
+			}
+			largest = math.Max(largest, total)
+		}
+		return largest
+	})
+	if err != nil {
+		return 0.0, err
+	}
+	largest := 0.0
+	for _, partial := range partials {
+		largest = math.Max(largest, partial)
+	}
+	return largest, nil
+}
+
+// SpectralNorm returns the largest singular value of m, which is the most m can stretch the
+// Euclidean length of a vector. Zero is returned for an empty matrix.
+// The value is the square root of the largest eigenvalue of the Gram matrix transpose(M) * M, or M * transpose(M)
+// if that is smaller, which is formed concurrently and diagonalised with the cyclic Jacobi method.
+// errNoConvergence is returned if the Jacobi iteration limit is reached.
+func (m Matrix[N]) SpectralNorm() (float64, error) {
+	return m.SpectralNormContext(context.Background())
+}
+
+// SpectralNormContext behaves as SpectralNorm but stops forming the Gram matrix or between Jacobi sweeps
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) SpectralNormContext(ctx context.Context) (float64, error) {
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	rows, columns := m.Dimensions()
+	if rows == 0 || columns == 0 {
+		return 0.0, nil
+	}
+	scale, err := m.maxAbs(ctx)
+	if err != nil || scale == 0.0 || math.IsInf(scale, 1) {
+		return scale, err
+	}
+
+	// scaling by the largest element keeps the squared elements of the Gram matrix in range
+	a := m.float64Copy()
+	if rows > columns {
+		a = a.transpose()
+	}
+	for _, row := range a {
+		for j := range row {
+			row[j] /= scale
+		}
+	}
+	gram, err := gramFloat64(ctx, a)
+	if err != nil {
+		return 0.0, err
+	}
+
+	threshold := spectralNormTolerance * frobenius(gram)
+	for sweep := 0; offDiagonalNorm(gram) > threshold; sweep++ {
+		if sweep == spectralNormMaxSweeps {
+			return 0.0, errNoConvergence
+		}
+		if err := ctx.Err(); err != nil {
+			return 0.0, err
+		}
+		for p := 0; p < len(gram)-1; p++ {
+			for q := p + 1; q < len(gram); q++ {
+				jacobiRotate(gram, p, q)
+			}
+		}
+	}
+
+	largest := 0.0
+	for i := range gram {
+		largest = math.Max(largest, gram[i][i])
+	}
+	return scale * math.Sqrt(largest), nil
+}
+
+// gramFloat64 returns a * transpose(a), computing chunks of rows concurrently.
+// Only the upper triangle is computed, the lower being filled in by symmetry.
+// ctx.Err() is returned if ctx is done before every row is computed.
+func gramFloat64(ctx context.Context, a Matrix[float64]) (Matrix[float64], error) {
+	rows, columns := a.Dimensions()
+	gram := NewZeroMatrix[float64](rows, rows)
+	err := parallelRowsContext(ctx, rows, rows*columns, func(start, end int) {
+		for i := start; i < end; i++ {
+			for j := i; j < rows; j++ {
+				total := 0.0
+				for k, element := range a[i] {
+					total += element * a[j][k]
+				}
+				gram[i][j] = total
+			}
+		}
+	})
+	if err != nil {
+		return Matrix[float64]{}, err
+	}
+	for i := 0; i < rows; i++ {
+		for j := 0; j < i; j++ {
+			gram[i][j] = gram[j][i]
+		}
+	}
+	return gram, nil
+}
+
+// jacobiRotate applies the plane rotation that zeroes a[p][q] and a[q][p] to both sides of the symmetric matrix a
+func jacobiRotate(a Matrix[float64], p, q int) {
+	if a[p][q] == 0.0 {
+		return
+	}
+	theta := (a[q][q] - a[p][p]) / (2.0 * a[p][q])
+	t := 1.0 / (math.Abs(theta) + math.Sqrt(theta*theta+1.0))
+	if theta < 0 {
+		t = -t
+	}
+	c := 1.0 / math.Sqrt(t*t+1.0)
+	s := t * c
+
+	for k := range a {
+		akp, akq := a[k][p], a[k][q]
+		a[k][p] = c*akp - s*akq
+		a[k][q] = s*akp + c*akq
+	}
+	for k := range a {
+		apk, aqk := a[p][k], a[q][k]
+		a[p][k] = c*apk - s*aqk
+		a[q][k] = s*apk + c*aqk
+	}
+	a[p][q], a[q][p] = 0.0, 0.0
+}
+
+// offDiagonalNorm returns the square root of the sum of squares of the off diagonal elements of a
+func offDiagonalNorm(a Matrix[float64]) float64 {
+	total := 0.0
+	for i := range a {
+		for j := range a[i] {
+			if i != j {
+				total += a[i][j] * a[i][j]
+			}
+		}
+	}
+	return math.Sqrt(total)
+}
+
+// frobenius returns the square root of the sum of squares of every element of a
+func frobenius(a Matrix[float64]) float64 {
+	total := 0.0
+	for i := range a {
+		for j := range a[i] {
+			total += a[i][j] * a[i][j]
+		}
+	}
+	return math.Sqrt(total)
+}
+
+// maxAbs returns the largest absolute value of the elements of m, or zero for an empty matrix.
+// ctx.Err() is returned if ctx is done before every element is read.
+func (m Matrix[N]) maxAbs(ctx context.Context) (float64, error) {
+	rows, columns := m.Dimensions()
+	partials, err := reduceRowsContext(ctx, rows, columns, func(start, end int) float64 {
+		largest := 0.0
+		for i := start; i < end; i++ {
+			for j := 0; j < columns; j++ {
+				largest = math.Max(largest, math.Abs(float64(m[i][j])))
+			}
+		}
+		return largest
+	})
+	if err != nil {
+		return 0.0, err
+	}
+	largest := 0.0
+	for _, partial := range partials {
+		largest = math.Max(largest, partial)
+	}
+	return largest, nil
+}
+
+// float64Difference returns v - u as float64 values so that unsigned elements do not wrap
+func (v Vector[N]) float64Difference(u Vector[N]) (Vector[float64], error) {
+	if len(v) != len(u) {
+		return Vector[float64]{}, errDifferentDimension
+	}
+	difference := make(Vector[float64], len(v))
+	parallelRange(len(v), minChunkElements, func(start, end int) {
+		for k := start; k < end; k++ {
+			difference[k] = float64(v[k]) - float64(u[k])
+		}
+	})
+	return difference, nil
+}
+
+// unitDistances returns the lengths of the difference and the sum of the unit vectors in the directions
+// of v and u, each built and measured concurrently
+func (v Vector[N]) unitDistances(u Vector[N]) (float64, float64, error) {
+	if len(v) != len(u) {
+		return 0.0, 0.0, errDifferentDimension
+	}
+	vNorm, uNorm := v.NormL2(), u.NormL2()
+	if vNorm == 0.0 || uNorm == 0.0 {
+		return 0.0, 0.0, errZeroNorm
+	}
+	difference, sum := make(Vector[float64], len(v)), make(Vector[float64], len(v))
+	parallelRange(len(v), minChunkElements, func(start, end int) {
+		for k := start; k < end; k++ {
+			vUnit, uUnit := float64(v[k])/vNorm, float64(u[k])/uNorm
+			difference[k], sum[k] = vUnit-uUnit, vUnit+uUnit
+		}
+	})
+	return difference.NormL2(), sum.NormL2(), nil
+}
+
+// scaled returns a copy of v with every element multiplied by factor
+func (v Vector[N]) scaled(factor float64) Vector[float64] {
+	out := make(Vector[float64], len(v))
+	parallelRange(len(v), minChunkElements, func(start, end int) {
+		for k := start; k < end; k++ {
+			out[k] = float64(v[k]) * factor
+		}
+	})
+	return out
+}
+
+// sumRange returns the compensated sum of term(k) over the indices [0, length), computed concurrently
+func sumRange(length int, term func(k int) float64) float64 {
+	partials := reduceRange(length, func(start, end int) compensatedSum {
+		var total compensatedSum
+		for k := start; k < end; k++ {
+			total.add(term(k))
+		}
+		return total
+	})
+	var total compensatedSum
+	for _, partial := range partials {
+		total.add(partial.sum)
+		total.add(partial.compensation)
+	}
+	return total.value()
+}
+
+// maxRange returns the largest term(k) over the indices [0, length), or zero if length is zero
+func maxRange(length int, term func(k int) float64) float64 {
+	partials := reduceRange(length, func(start, end int) float64 {
+		largest := 0.0
+		for k := start; k < end; k++ {
+			largest = math.Max(largest, term(k))
+		}
+		return largest
+	})
+	largest := 0.0
+	for _, partial := range partials {
+		largest = math.Max(largest, partial)
+	}
+	return largest
+}
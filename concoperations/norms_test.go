@@ -0,0 +1,112 @@
+package concoperations_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+func randomFloatVector(length int, r *rand.Rand) []float64 {
+	v := make([]float64, length)
+	for k := range v {
+		v[k] = r.NormFloat64()
+	}
+	return v
+}
+
+/*
+Test norms agree with seqoperations
+*/
+
+func TestVectorNormsMatchSequential(t *testing.T) {
+	withProcs(t, 8, func() {
+		r := rand.New(rand.NewSource(3))
+		values, others := randomFloatVector(30000, r), randomFloatVector(30000, r)
+		c, s := concoperations.Vector[float64](values), seqoperations.Vector[float64](values)
+		cOther, sOther := concoperations.Vector[float64](others), seqoperations.Vector[float64](others)
+
+		assert.InDelta(t, s.NormL1(), c.NormL1(), 1e-9)
+		assert.InDelta(t, s.NormL2(), c.NormL2(), 1e-9)
+		assert.Equal(t, s.NormInf(), c.NormInf())
+		for _, p := range []float64{1, 1.5, 2, 3, math.Inf(1)} {
+			sNorm, _ := s.NormP(p)
+			cNorm, err := c.NormP(p)
+			assert.Nil(t, err)
+			assert.InDelta(t, sNorm, cNorm, 1e-9)
+		}
+		_, err := c.NormP(0)
+		assert.Equal(t, e.ErrNormOrder, err)
+
+		sDistance, _ := s.EuclideanDistance(sOther)
+		cDistance, err := c.EuclideanDistance(cOther)
+		assert.Nil(t, err)
+		assert.InDelta(t, sDistance, cDistance, 1e-9)
+		sDistance, _ = s.ManhattanDistance(sOther)
+		cDistance, err = c.ManhattanDistance(cOther)
+		assert.Nil(t, err)
+		assert.InDelta(t, sDistance, cDistance, 1e-9)
+		sDistance, _ = s.CosineDistance(sOther)
+		cDistance, err = c.CosineDistance(cOther)
+		assert.Nil(t, err)
+		assert.InDelta(t, sDistance, cDistance, 1e-12)
+
+		_, err = c.EuclideanDistance(cOther[:5])
+		assert.Equal(t, e.ErrDifferentDimension, err)
+		_, err = c.CosineDistance(make(concoperations.Vector[float64], 30000))
+		assert.Equal(t, e.ErrZeroNorm, err)
+	})
+}
+
+func TestMatrixNormsMatchSequential(t *testing.T) {
+	withProcs(t, 8, func() {
+		r := rand.New(rand.NewSource(11))
+		for _, shape := range [][2]int{{1, 1}, {7, 3}, {3, 7}, {200, 90}} {
+			values := randomFloatVector(shape[0]*shape[1], r)
+			c := concoperations.NewMatrixFromSlice(shape[0], shape[1], values)
+			s := seqoperations.NewMatrixFromSlice(shape[0], shape[1], values)
+
+			sNorms := []func() (float64, error){s.FrobeniusNorm, s.Norm1, s.NormInf, s.SpectralNorm}
+			cNorms := []func() (float64, error){c.FrobeniusNorm, c.Norm1, c.NormInf, c.SpectralNorm}
+			for k := range sNorms {
+				expected, err := sNorms[k]()
+				assert.Nil(t, err)
+				actual, err := cNorms[k]()
+				assert.Nil(t, err)
+				assert.InDelta(t, expected, actual, 1e-12*expected)
+			}
+		}
+
+		spectral, err := concoperations.NewZeroMatrix[int](3, 3).SpectralNorm()
+		assert.Nil(t, err)
+		assert.Equal(t, 0.0, spectral)
+		for _, ragged := range []concoperations.Matrix[int]{{{1, 2}, {3}}, {{1}, {2, 3}}} {
+			for _, norm := range []func() (float64, error){ragged.FrobeniusNorm, ragged.Norm1, ragged.NormInf, ragged.SpectralNorm} {
+				_, err = norm()
+				assert.Equal(t, e.ErrRagged, err)
+			}
+		}
+	})
+}
+
+func TestSpectralNormNearEqualSingularValues(t *testing.T) {
+	for _, second := range []float64{0.999, 0.99999, 1.0 - 1e-12, 1.0} {
+		spectral, err := concoperations.Matrix[float64]{{1, 0}, {0, second}}.SpectralNorm()
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.0, spectral, 1e-15)
+
+		// the same singular values hidden by rotating the rows and columns of a wider matrix
+		c, s := math.Cos(0.3), math.Sin(0.3)
+		rotated := concoperations.Matrix[float64]{
+			{c, -s * second, 0},
+			{s, c * second, 0},
+		}
+		spectral, err = rotated.SpectralNorm()
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.0, spectral, 1e-15)
+	}
+}
@@ -0,0 +1,69 @@
+package concoperations
+
+import (
+	"runtime"
+
+	"github.com/DominicHinton/matrix/internal/textio"
+)
+
+// DefaultSequentialThreshold is the number of scalar multiplications below which
+// Multiply does not split work across the worker pool
+const DefaultSequentialThreshold = 1 << 18
+
+// multiplySettings holds the settings used by Multiply
+type multiplySettings struct {
+	parallelism         int
+	sequentialThreshold int
+}
+
+// MultiplyOption adjusts how Multiply divides its work
+type MultiplyOption func(*multiplySettings)
+
+// WithParallelism sets the greatest number of bands of rows that Multiply computes concurrently.
+// The default is GOMAXPROCS and values that are not positive are ignored.
+func WithParallelism(parallelism int) MultiplyOption {
+	return func(s *multiplySettings) {
+		if parallelism > 0 {
+			s.parallelism = parallelism
+		}
+	}
+}
+
+// WithSequentialThreshold sets the number of scalar multiplications below which Multiply
+// runs on the calling goroutine alone. Values that are negative are ignored.
+func WithSequentialThreshold(multiplications int) MultiplyOption {
+	return func(s *multiplySettings) {
+		if multiplications >= 0 {
+			s.sequentialThreshold = multiplications
+		}
+	}
+}
+
+// newMultiplySettings returns the default settings with each option applied in turn
+func newMultiplySettings(options []MultiplyOption) multiplySettings {
+	settings := multiplySettings{parallelism: runtime.GOMAXPROCS(0), sequentialThreshold: DefaultSequentialThreshold}
+	for _, option := range options {
+		option(&settings)
+	}
+	return settings
+}
+
+// CSVOption adjusts the layout of delimited text read by ReadCSV and written by WriteCSV
+type CSVOption = textio.CSVOption
+
+// WithDelimiter sets the rune separating fields, which is a comma by default.
+// ReadCSV and WriteCSV return errors.ErrInvalidDelimiter for a delimiter that encoding/csv cannot use,
+// such as a quote or newline.
+func WithDelimiter(delimiter rune) CSVOption {
+	return textio.WithDelimiter(delimiter)
+}
+
+// WithHeader makes ReadCSV skip the first record, which holds column names rather than values
+func WithHeader() CSVOption {
+	return textio.WithHeader()
+}
+
+// WithColumnNames makes WriteCSV write names as a header record before the values
+func WithColumnNames(names ...string) CSVOption {
+	return textio.WithColumnNames(names...)
+}
@@ -0,0 +1,139 @@
+package concoperations
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// minChunkElements is the least number of elements worth handing to a separate worker.
+// Smaller pieces of work cost more to schedule than they save.
+const minChunkElements = 4096
+
+// workerPool is a fixed set of goroutines that execute submitted tasks.
+// The tasks channel is unbuffered so a send only succeeds when a worker is idle.
+type workerPool struct {
+	tasks chan func()
+}
+
+var (
+	sharedPool     *workerPool
+	sharedPoolOnce sync.Once
+)
+
+// pool returns the package wide worker pool, starting GOMAXPROCS workers on first use
+func pool() *workerPool {
+	sharedPoolOnce.Do(func() {
+		sharedPool = newWorkerPool(runtime.GOMAXPROCS(0))
+	})
+	return sharedPool
+}
+
+// newWorkerPool starts a pool of the supplied number of workers, which run for the life of the program
+func newWorkerPool(workers int) *workerPool {
+	p := &workerPool{tasks: make(chan func())}
+	for w := 0; w < workers; w++ {
+		go func() {
+			for task := range p.tasks {
+				task()
+			}
+		}()
+	}
+	return p
+}
+
+// run executes every task and returns once all have completed.
+// The first task runs on the calling goroutine and any task that cannot be handed to an idle worker
+// also runs there, so work is never queued behind a busy pool and nested calls cannot deadlock.
+func (p *workerPool) run(tasks []func()) {
+	if len(tasks) == 0 {
+		return
+	}
+	var wg sync.WaitGroup
+	wg.Add(len(tasks) - 1)
+	for _, task := range tasks[1:] {
+		t := task
+		wrapped := func() {
+			defer wg.Done()
+			t()
+		}
+		select {
+		case p.tasks <- wrapped:
+		default:
+			wrapped()
+		}
+	}
+	tasks[0]()
+	wg.Wait()
+}
+
+// parallelRange splits the indices [0, length) into at most GOMAXPROCS contiguous chunks of at
+// least grain indices and calls fn(start, end) for each chunk on the shared worker pool.
+// Ranges too small to split are handled by a single call on the calling goroutine.
+func parallelRange(length, grain int, fn func(start, end int)) {
+	_ = parallelRangeContext(context.Background(), length, grain, fn)
+}
+
+// parallelRangeContext behaves as parallelRange but chunks that have not started when ctx is done are
+// skipped. ctx.Err() is returned if ctx is done once every started chunk has finished, in which case
+// fn may not have been called for every index.
+func parallelRangeContext(ctx context.Context, length, grain int, fn func(start, end int)) error {
+	return parallelRangeLimit(ctx, length, grain, runtime.GOMAXPROCS(0), fn)
+}
+
+// parallelRangeLimit behaves as parallelRangeContext but splits the range into at most limit chunks
+func parallelRangeLimit(ctx context.Context, length, grain, limit int, fn func(start, end int)) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if length <= 0 {
+		return nil
+	}
+	if grain < 1 {
+		grain = 1
+	}
+	chunks := limit
+	if maxChunks := (length + grain - 1) / grain; maxChunks < chunks {
+		chunks = maxChunks
+	}
+	if chunks <= 1 {
+		fn(0, length)
+		return ctx.Err()
+	}
+
+	size := (length + chunks - 1) / chunks
+	tasks := make([]func(), 0, chunks)
+	for start := 0; start < length; start += size {
+		s, e := start, start+size
+		if e > length {
+			e = length
+		}
+		tasks = append(tasks, func() {
+			if ctx.Err() != nil {
+				return
+			}
+			fn(s, e)
+		})
+	}
+	pool().run(tasks)
+	return ctx.Err()
+}
+
+// parallelRows calls fn(start, end) over chunks of the rows of a matrix with the supplied number
+// of columns, sizing chunks so that each holds at least minChunkElements elements
+func parallelRows(rows, columns int, fn func(start, end int)) {
+	parallelRange(rows, rowGrain(columns), fn)
+}
+
+// parallelRowsContext behaves as parallelRows but stops handing out chunks once ctx is done
+func parallelRowsContext(ctx context.Context, rows, columns int, fn func(start, end int)) error {
+	return parallelRangeContext(ctx, rows, rowGrain(columns), fn)
+}
+
+// rowGrain returns the least number of rows of the supplied width worth handing to a separate worker
+func rowGrain(columns int) int {
+	if columns < 1 {
+		return minChunkElements
+	}
+	return (minChunkElements + columns - 1) / columns
+}
@@ -0,0 +1,138 @@
+package concoperations_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+// withProcs runs fn with GOMAXPROCS raised so that work is split across the pool even on small machines.
+// The previous setting is restored when the test and its subtests finish.
+func withProcs(t *testing.T, procs int, fn func()) {
+	t.Helper()
+	previous := runtime.GOMAXPROCS(procs)
+	t.Cleanup(func() { runtime.GOMAXPROCS(previous) })
+	fn()
+}
+
+func sequentialInput(length int) []int {
+	input := make([]int, length)
+	for k := range input {
+		input[k] = k%97 - 48
+	}
+	return input
+}
+
+/*
+Test pooled operations agree with seqoperations
+*/
+
+func TestPooledOperationsMatchSequential(t *testing.T) {
+	withProcs(t, 8, func() {
+		for _, shape := range [][2]int{{0, 0}, {1, 1}, {3, 5000}, {300, 301}, {5000, 2}} {
+			rows, columns := shape[0], shape[1]
+			input := sequentialInput(rows*columns + 1)
+
+			c := concoperations.NewMatrixFromSlice(rows, columns, input)
+			s := seqoperations.NewMatrixFromSlice(rows, columns, input)
+			assert.Equal(t, seqoperations.Matrix[int](s), seqoperations.Matrix[int](c))
+
+			assert.Equal(t, s.AddToElements(3), seqoperations.Matrix[int](c.AddToElements(3)))
+
+			cSum, err := c.AddMatrices(c)
+			assert.Nil(t, err)
+			sSum, _ := s.AddMatrices(s)
+			assert.Equal(t, sSum, seqoperations.Matrix[int](cSum))
+
+			assert.Equal(t, s.Float64Copy(), seqoperations.Matrix[float64](c.Float64Copy()))
+			assert.Equal(t, s.Copy(), seqoperations.Matrix[int](c.Copy()))
+
+			c.FillMatrix(7)
+			s.FillMatrix(7)
+			assert.Equal(t, s, seqoperations.Matrix[int](c))
+		}
+	})
+}
+
+func TestPooledVectorOperations(t *testing.T) {
+	withProcs(t, 8, func() {
+		m := concoperations.NewMatrixFromSlice(20000, 2, sequentialInput(40000))
+		column, ok := m.VectorFromColumn(1)
+		assert.True(t, ok)
+		doubled := column.MultiplyElementsBy(2)
+		for k := range column {
+			assert.Equal(t, m[k][1], column[k])
+			assert.Equal(t, 2*m[k][1], doubled[k])
+		}
+	})
+}
+
+func TestNestedPoolUseDoesNotDeadlock(t *testing.T) {
+	withProcs(t, 4, func() {
+		outer := concoperations.NewZeroMatrix[int](4097, 1)
+		mapped := outer.MapFunctionToElements(func(element int) int {
+			// each element builds and maps another matrix from inside a pooled task
+			inner := concoperations.NewConstantMatrix(64, 128, 1)
+			return element + len(inner.AddToElements(1))
+		})
+		assert.Equal(t, 64, mapped[4096][0])
+	})
+}
+
+/*
+Benchmark pooled operations against seqoperations
+*/
+
+func BenchmarkMapFunctionToElements(b *testing.B) {
+	for _, size := range []int{100, 500, 2000} {
+		c := concoperations.NewMatrixFromSlice(size, size, sequentialInput(size*size))
+		s := seqoperations.NewMatrixFromSlice(size, size, sequentialInput(size*size))
+		b.Run(fmt.Sprintf("concurrent/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = c.AddToElements(1)
+			}
+		})
+		b.Run(fmt.Sprintf("sequential/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = s.AddToElements(1)
+			}
+		})
+	}
+}
+
+func BenchmarkApplyOneToOne(b *testing.B) {
+	for _, size := range []int{100, 500, 2000} {
+		c := concoperations.NewMatrixFromSlice(size, size, sequentialInput(size*size))
+		s := seqoperations.NewMatrixFromSlice(size, size, sequentialInput(size*size))
+		b.Run(fmt.Sprintf("concurrent/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = c.ElementWiseMultiply(c)
+			}
+		})
+		b.Run(fmt.Sprintf("sequential/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = s.ElementWiseMultiply(s)
+			}
+		})
+	}
+}
+
+func BenchmarkNewMatrixFromSlice(b *testing.B) {
+	for _, size := range []int{100, 500, 2000} {
+		input := sequentialInput(size * size)
+		b.Run(fmt.Sprintf("concurrent/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = concoperations.NewMatrixFromSlice(size, size, input)
+			}
+		})
+		b.Run(fmt.Sprintf("sequential/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = seqoperations.NewMatrixFromSlice(size, size, input)
+			}
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package concoperations
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// Reduce combines every element of m into a single value with fn, starting from identity.
+// Chunks of rows are reduced concurrently and their results then combined in row order,
+// so fn must be associative and fn(identity, x) must equal x for every x.
+// identity is returned for a matrix with no elements and errRagged if the rows of m are not all the same length.
+func (m Matrix[N]) Reduce(fn OneToOneSequentialOperater[N], identity N) (N, error) {
+	return m.ReduceContext(context.Background(), fn, identity)
+}
+
+// ReduceContext behaves as Reduce but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) ReduceContext(ctx context.Context, fn OneToOneSequentialOperater[N], identity N) (N, error) {
+	if err := m.Validate(); err != nil {
+		return N(0), err
+	}
+	return m.reduce(ctx, fn, identity)
+}
+
+// reduce carries out ReduceContext on m, which must not be ragged
+func (m Matrix[N]) reduce(ctx context.Context, fn OneToOneSequentialOperater[N], identity N) (N, error) {
+	rows, columns := m.Dimensions()
+	partials, err := reduceRowsContext(ctx, rows, columns, func(start, end int) N {
+		total := identity
+		for i := start; i < end; i++ {
+			for j := 0; j < columns; j++ {
+				total = fn(total, m[i][j])
+			}
+		}
+		return total
+	})
+	if err != nil {
+		return N(0), err
+	}
+	total := identity
+	for _, partial := range partials {
+		total = fn(total, partial)
+	}
+	return total, nil
+}
+
+// Sum returns the sum of every element of m.
+// Float elements are summed in float64 with compensated summation so that the rounding error
+// does not grow with the number of elements.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) Sum() (N, error) {
+	return m.SumContext(context.Background())
+}
+
+// SumContext behaves as Sum but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) SumContext(ctx context.Context) (N, error) {
+	if err := m.Validate(); err != nil {
+		return N(0), err
+	}
+	if !isFloat[N]() {
+		return m.reduce(ctx, Add[N], N(0))
+	}
+	total, err := m.sumFloat64(ctx, func(element N) float64 { return float64(element) })
+	return N(total), err
+}
+
+// Min returns the smallest element of m and true if m contains elements and is not ragged,
+// 0 and false otherwise
+func (m Matrix[N]) Min() (N, bool) {
+	min, err := m.MinContext(context.Background())
+	return min, err == nil
+}
+
+// MinContext behaves as Min but reports an empty matrix with errZeroLength and a ragged one with errRagged.
+// It stops scheduling work and returns ctx.Err() if ctx is cancelled or its deadline passes.
+func (m Matrix[N]) MinContext(ctx context.Context) (N, error) {
+	if err := m.validateNonEmpty(); err != nil {
+		return N(0), err
+	}
+	return m.reduce(ctx, minimum[N], m[0][0])
+}
+
+// Max returns the largest element of m and true if m contains elements and is not ragged,
+// 0 and false otherwise
+func (m Matrix[N]) Max() (N, bool) {
+	max, err := m.MaxContext(context.Background())
+	return max, err == nil
+}
+
+// MaxContext behaves as Max but reports an empty matrix with errZeroLength and a ragged one with errRagged.
+// It stops scheduling work and returns ctx.Err() if ctx is cancelled or its deadline passes.
+func (m Matrix[N]) MaxContext(ctx context.Context) (N, error) {
+	if err := m.validateNonEmpty(); err != nil {
+		return N(0), err
+	}
+	return m.reduce(ctx, maximum[N], m[0][0])
+}
+
+// ArgMax returns the row and column of the largest element of m and true if m contains elements
+// and is not ragged. If the largest value occurs more than once the first in row major order is returned.
+func (m Matrix[N]) ArgMax() (int, int, bool) {
+	row, column, err := m.ArgMaxContext(context.Background())
+	return row, column, err == nil
+}
+
+// ArgMaxContext behaves as ArgMax but reports an empty matrix with errZeroLength and a ragged one with errRagged.
+// It stops scheduling work and returns ctx.Err() if ctx is cancelled or its deadline passes.
+func (m Matrix[N]) ArgMaxContext(ctx context.Context) (int, int, error) {
+	if err := m.validateNonEmpty(); err != nil {
+		return -1, -1, err
+	}
+	rows, columns := m.Dimensions()
+	type position struct{ row, column int }
+	partials, err := reduceRowsContext(ctx, rows, columns, func(start, end int) position {
+		best := position{start, 0}
+		for i := start; i < end; i++ {
+			for j := 0; j < columns; j++ {
+				if m[i][j] > m[best.row][best.column] {
+					best = position{i, j}
+				}
+			}
+		}
+		return best
+	})
+	if err != nil {
+		return -1, -1, err
+	}
+	best := partials[0]
+	for _, partial := range partials[1:] {
+		if m[partial.row][partial.column] > m[best.row][best.column] {
+			best = partial
+		}
+	}
+	return best.row, best.column, nil
+}
+
+// validateNonEmpty returns errRagged if m is ragged and errZeroLength if it contains no elements
+func (m Matrix[N]) validateNonEmpty() error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	if rows, columns := m.Dimensions(); rows == 0 || columns == 0 {
+		return errZeroLength
+	}
+	return nil
+}
+
+// sumFloat64 returns the compensated sum of term applied to every element of m, computed concurrently.
+// m must not be ragged. ctx.Err() is returned if ctx is done before every element is summed.
+func (m Matrix[N]) sumFloat64(ctx context.Context, term func(N) float64) (float64, error) {
+	rows, columns := m.Dimensions()
+	partials, err := reduceRowsContext(ctx, rows, columns, func(start, end int) compensatedSum {
+		var total compensatedSum
+		for i := start; i < end; i++ {
+			for j := 0; j < columns; j++ {
+				total.add(term(m[i][j]))
+			}
+		}
+		return total
+	})
+	if err != nil {
+		return 0.0, err
+	}
+	var total compensatedSum
+	for _, partial := range partials {
+		total.add(partial.sum)
+		total.add(partial.compensation)
+	}
+	return total.value(), nil
+}
+
+// Reduce combines every element of v into a single value with fn, starting from identity.
+// Chunks of v are reduced concurrently and their results then combined in order,
+// so fn must be associative and fn(identity, x) must equal x for every x.
+// identity is returned for an empty vector.
+func (v Vector[N]) Reduce(fn OneToOneSequentialOperater[N], identity N) N {
+	partials := reduceRange(len(v), func(start, end int) N {
+		total := identity
+		for k := start; k < end; k++ {
+			total = fn(total, v[k])
+		}
+		return total
+	})
+	total := identity
+	for _, partial := range partials {
+		total = fn(total, partial)
+	}
+	return total
+}
+
+// Sum returns the sum of every element of v, using compensated summation for float elements
+func (v Vector[N]) Sum() N {
+	if !isFloat[N]() {
+		return v.Reduce(Add[N], N(0))
+	}
+	return N(sumRange(len(v), func(k int) float64 { return float64(v[k]) }))
+}
+
+// Min returns the smallest element of v and true if v contains elements, 0 and false otherwise
+func (v Vector[N]) Min() (N, bool) {
+	if len(v) == 0 {
+		return N(0), false
+	}
+	return v.Reduce(minimum[N], v[0]), true
+}
+
+// Max returns the largest element of v and true if v contains elements, 0 and false otherwise
+func (v Vector[N]) Max() (N, bool) {
+	if len(v) == 0 {
+		return N(0), false
+	}
+	return v.Reduce(maximum[N], v[0]), true
+}
+
+// ArgMax returns the index of the largest element of v and true if v contains elements.
+// If the largest value occurs more than once the first index is returned.
+func (v Vector[N]) ArgMax() (int, bool) {
+	if len(v) == 0 {
+		return -1, false
+	}
+	partials := reduceRange(len(v), func(start, end int) int {
+		best := start
+		for k := start; k < end; k++ {
+			if v[k] > v[best] {
+				best = k
+			}
+		}
+		return best
+	})
+	best := partials[0]
+	for _, partial := range partials[1:] {
+		if v[partial] > v[best] {
+			best = partial
+		}
+	}
+	return best, true
+}
+
+// compensatedSum is a running float64 total that carries the rounding error of every addition
+// forward using the Kahan-Babuska-Neumaier algorithm
+type compensatedSum struct {
+	sum, compensation float64
+}
+
+// add adds x to the running total
+func (c *compensatedSum) add(x float64) {
+	t := c.sum + x
+	if abs(c.sum) >= abs(x) {
+		c.compensation += (c.sum - t) + x
+	} else {
+		c.compensation += (x - t) + c.sum
+	}
+	c.sum = t
+}
+
+// value returns the running total corrected by the accumulated rounding error
+func (c compensatedSum) value() float64 {
+	return c.sum + c.compensation
+}
+
+// reduceRows calls reduceChunk concurrently over chunks of the rows of a matrix with the supplied
+// number of columns and returns the results ordered by the first row of each chunk
+func reduceRows[T any](rows, columns int, reduceChunk func(start, end int) T) []T {
+	partials, _ := reduceRowsContext(context.Background(), rows, columns, reduceChunk)
+	return partials
+}
+
+// reduceRowsContext behaves as reduceRows but stops handing out chunks once ctx is done,
+// in which case ctx.Err() is returned and the results are incomplete
+func reduceRowsContext[T any](ctx context.Context, rows, columns int, reduceChunk func(start, end int) T) ([]T, error) {
+	return collectPartials(func(fn func(start, end int)) error {
+		return parallelRowsContext(ctx, rows, columns, fn)
+	}, reduceChunk)
+}
+
+// reduceRange calls reduceChunk concurrently over chunks of the indices [0, length)
+// and returns the results ordered by the first index of each chunk
+func reduceRange[T any](length int, reduceChunk func(start, end int) T) []T {
+	partials, _ := collectPartials(func(fn func(start, end int)) error {
+		parallelRange(length, minChunkElements, fn)
+		return nil
+	}, reduceChunk)
+	return partials
+}
+
+// collectPartials runs reduceChunk over the chunks produced by split and orders the results by chunk start,
+// so that reductions are combined in the same order however the chunks were scheduled.
+// Any error from split is returned alongside the results of the chunks that ran.
+func collectPartials[T any](split func(func(start, end int)) error, reduceChunk func(start, end int) T) ([]T, error) {
+	type partial struct {
+		start int
+		value T
+	}
+	var mu sync.Mutex
+	partials := []partial{}
+	err := split(func(start, end int) {
+		value := reduceChunk(start, end)
+		mu.Lock()
+		partials = append(partials, partial{start, value})
+		mu.Unlock()
+	})
+	sort.Slice(partials, func(i, j int) bool { return partials[i].start < partials[j].start })
+
+	values := make([]T, len(partials))
+	for k, p := range partials {
+		values[k] = p.value
+	}
+	return values, err
+}
+
+func minimum[N Number](a, b N) N {
+	if b < a {
+		return b
+	}
+	return a
+}
+
+func maximum[N Number](a, b N) N {
+	if b > a {
+		return b
+	}
+	return a
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// isFloat returns true if N is a floating point type
+func isFloat[N Number]() bool {
+	switch any(N(0)).(type) {
+	case float32, float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,152 @@
+package concoperations_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test Reduce
+*/
+
+func TestReduce(t *testing.T) {
+	withProcs(t, 8, func() {
+		m := concoperations.NewMatrixFromSlice(300, 301, sequentialInput(300*301))
+		expected := 0
+		for _, row := range m {
+			for _, element := range row {
+				expected += element
+			}
+		}
+		reduced, err := m.Reduce(concoperations.Add[int], 0)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, reduced)
+		sum, err := m.Sum()
+		assert.Nil(t, err)
+		assert.Equal(t, expected, sum)
+
+		v := concoperations.Vector[int](sequentialInput(50000))
+		expected = 0
+		for _, element := range v {
+			expected += element
+		}
+		assert.Equal(t, expected, v.Reduce(concoperations.Add[int], 0))
+		assert.Equal(t, expected, v.Sum())
+
+		reduced, err = concoperations.Matrix[int]{}.Reduce(concoperations.Add[int], 7)
+		assert.Nil(t, err)
+		assert.Equal(t, 7, reduced)
+		assert.Equal(t, 7, concoperations.Vector[int]{}.Reduce(concoperations.Add[int], 7))
+	})
+}
+
+func TestSumIsCompensatedForFloats(t *testing.T) {
+	withProcs(t, 8, func() {
+		// naive summation of 1 followed by many values below its rounding error loses them entirely
+		v := make(concoperations.Vector[float64], 100001)
+		v[0] = 1.0
+		for k := 1; k < len(v); k++ {
+			v[k] = 1e-16
+		}
+		assert.InDelta(t, 1.0+1e-11, v.Sum(), 1e-15)
+
+		m := concoperations.NewMatrixFromSlice(1000, 100, v[:100000])
+		sum, err := m.Sum()
+		assert.Nil(t, err)
+		assert.InDelta(t, 1.0+99999e-16, sum, 1e-15)
+	})
+}
+
+/*
+Test Min, Max and ArgMax
+*/
+
+func TestMinMaxArgMax(t *testing.T) {
+	withProcs(t, 8, func() {
+		m := concoperations.NewMatrixFromSlice(400, 50, sequentialInput(20000))
+		m[123][45] = 1000
+		m[300][2] = 1000
+		m[250][7] = -1000
+
+		min, ok := m.Min()
+		assert.True(t, ok)
+		assert.Equal(t, -1000, min)
+		max, ok := m.Max()
+		assert.True(t, ok)
+		assert.Equal(t, 1000, max)
+		row, column, ok := m.ArgMax()
+		assert.True(t, ok)
+		assert.Equal(t, 123, row)
+		assert.Equal(t, 45, column)
+
+		v := concoperations.Vector[float64]{3, -2, 9, 1, 9}
+		vMin, ok := v.Min()
+		assert.True(t, ok)
+		assert.Equal(t, -2.0, vMin)
+		vMax, ok := v.Max()
+		assert.True(t, ok)
+		assert.Equal(t, 9.0, vMax)
+		index, ok := v.ArgMax()
+		assert.True(t, ok)
+		assert.Equal(t, 2, index)
+	})
+
+	_, ok := concoperations.Matrix[int]{}.Min()
+	assert.False(t, ok)
+	_, ok = concoperations.Vector[int]{}.Max()
+	assert.False(t, ok)
+	_, _, ok = concoperations.NewZeroMatrix[int](3, 0).ArgMax()
+	assert.False(t, ok)
+	_, ok = concoperations.Vector[int]{}.ArgMax()
+	assert.False(t, ok)
+}
+
+func TestReductionsOfRaggedMatrix(t *testing.T) {
+	for _, ragged := range []concoperations.Matrix[int]{{{1, 2}, {3}}, {{1}, {2, 3}}} {
+		_, err := ragged.Reduce(concoperations.Add[int], 0)
+		assert.Equal(t, e.ErrRagged, err)
+		_, err = ragged.Sum()
+		assert.Equal(t, e.ErrRagged, err)
+
+		_, ok := ragged.Min()
+		assert.False(t, ok)
+		_, ok = ragged.Max()
+		assert.False(t, ok)
+		_, _, ok = ragged.ArgMax()
+		assert.False(t, ok)
+	}
+}
+
+/*
+Test Mean and MeanStandardDev agree with seqoperations
+*/
+
+func TestMeanStandardDevMatchesSequential(t *testing.T) {
+	withProcs(t, 8, func() {
+		input := sequentialInput(500 * 90)
+		c := concoperations.NewMatrixFromSlice(500, 90, input)
+		s := seqoperations.NewMatrixFromSlice(500, 90, input)
+
+		cMean, ok := c.Mean()
+		assert.True(t, ok)
+		sMean, _ := s.Mean()
+		assert.InDelta(t, sMean, cMean, 1e-12)
+
+		cMean, cStdev, ok := c.MeanStandardDev()
+		assert.True(t, ok)
+		sMean, sStdev, _ := s.MeanStandardDev()
+		assert.InDelta(t, sMean, cMean, 1e-12)
+		assert.InDelta(t, sStdev, cStdev, 1e-9)
+		assert.False(t, math.IsNaN(cStdev))
+	})
+
+	_, ok := concoperations.Matrix[int]{}.Mean()
+	assert.False(t, ok)
+	_, _, ok = concoperations.Matrix[int]{}.MeanStandardDev()
+	assert.False(t, ok)
+}
@@ -0,0 +1,80 @@
+package concoperations
+
+import "context"
+
+// Solve returns the vector x satisfying M * x = b for a square, non-singular matrix M.
+// The system is solved by LU decomposition with partial pivoting rather than by forming the inverse.
+// errMultiplicationValidity is returned if the length of b does not match the rows of M
+// and errNoInverse is returned if M is singular.
+func (m Matrix[N]) Solve(b Vector[N]) (Vector[float64], error) {
+	return m.SolveContext(context.Background(), b)
+}
+
+// SolveContext behaves as Solve but stops between elimination steps
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) SolveContext(ctx context.Context, b Vector[N]) (Vector[float64], error) {
+	if err := m.Validate(); err != nil {
+		return Vector[float64]{}, err
+	}
+	rows, _ := m.Dimensions()
+	if len(b) != rows {
+		return Vector[float64]{}, errMultiplicationValidity
+	}
+
+	lu, perm, err := luFactoriseNonSingular(ctx, m.float64Copy())
+	if err != nil {
+		return Vector[float64]{}, err
+	}
+
+	rhs := make([]float64, rows)
+	for i := 0; i < rows; i++ {
+		rhs[i] = float64(b[i])
+	}
+	return Vector[float64](luSolve(lu, perm, rhs)), nil
+}
+
+// SolveMatrix returns the matrix X satisfying M * X = B for a square, non-singular matrix M.
+// M is factorised once and the columns of B are solved concurrently against the same factorisation.
+// errMultiplicationValidity is returned if the rows of B do not match the rows of M
+// and errNoInverse is returned if M is singular.
+func (m Matrix[N]) SolveMatrix(b Matrix[N]) (Matrix[float64], error) {
+	return m.SolveMatrixContext(context.Background(), b)
+}
+
+// SolveMatrixContext behaves as SolveMatrix but stops scheduling work
+// and returns ctx.Err() if ctx is cancelled or its deadline passes
+func (m Matrix[N]) SolveMatrixContext(ctx context.Context, b Matrix[N]) (Matrix[float64], error) {
+	if err := validate(m, b); err != nil {
+		return Matrix[float64]{}, err
+	}
+	rows, _ := m.Dimensions()
+	bRows, bColumns := b.Dimensions()
+	if bRows != rows {
+		return Matrix[float64]{}, errMultiplicationValidity
+	}
+
+	lu, perm, err := luFactoriseNonSingular(ctx, m.float64Copy())
+	if err != nil {
+		return Matrix[float64]{}, err
+	}
+
+	// columns of B are independent once M is factorised so are solved concurrently
+	out := NewZeroMatrix[float64](rows, bColumns)
+	// each column costs a forward and back substitution, roughly rows * rows operations
+	err = parallelRangeContext(ctx, bColumns, rowGrain(rows*rows), func(start, end int) {
+		rhs := make([]float64, rows)
+		for j := start; j < end; j++ {
+			for i := 0; i < rows; i++ {
+				rhs[i] = float64(b[i][j])
+			}
+			x := luSolve(lu, perm, rhs)
+			for i := 0; i < rows; i++ {
+				out[i][j] = x[i]
+			}
+		}
+	})
+	if err != nil {
+		return Matrix[float64]{}, err
+	}
+	return out, nil
+}
@@ -0,0 +1,77 @@
+package concoperations_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+// These tests solve the columns of B concurrently so are intended to be run with -race
+
+/*
+Test Solve and SolveMatrix agree with seqoperations
+*/
+
+func TestSolveMatchesSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(7))
+	withProcs(t, 8, func() {
+		for _, size := range []int{1, 4, 50, 150} {
+			c := randomMatrix(size, size, r)
+			c[0][0] = 11 // keeps a 1x1 system non singular
+			s := seqoperations.Matrix[int](c)
+			b := randomMatrix(1, size, r)[0]
+
+			expected, err := s.Solve(seqoperations.Vector[int](b))
+			assert.Nil(t, err)
+			actual, err := c.Solve(concoperations.Vector[int](b))
+			assert.Nil(t, err)
+			assert.InDeltaSlice(t, []float64(expected), []float64(actual), 1e-9)
+		}
+	})
+}
+
+func TestSolveMatrixMatchesSequential(t *testing.T) {
+	r := rand.New(rand.NewSource(8))
+	withProcs(t, 8, func() {
+		for _, shape := range [][2]int{{3, 1}, {20, 64}, {150, 40}} {
+			c, b := randomMatrix(shape[0], shape[0], r), randomMatrix(shape[0], shape[1], r)
+			s := seqoperations.Matrix[int](c)
+
+			expected, err := s.SolveMatrix(seqoperations.Matrix[int](b))
+			assert.Nil(t, err)
+			actual, err := c.SolveMatrix(b)
+			assert.Nil(t, err)
+			assertMatchesSequential(t, expected, actual, 1e-9)
+		}
+	})
+}
+
+func TestSolveErrorsMatchSequential(t *testing.T) {
+	for _, system := range []struct {
+		m [][]int
+		b []int
+	}{
+		{[][]int{}, []int{}},
+		{[][]int{{1, 2, 3}, {4, 5, 6}}, []int{1, 2}},
+		{[][]int{{1, 2}, {3, 4}}, []int{1, 2, 3}},
+		{[][]int{{1, 2}, {2, 4}}, []int{1, 2}},
+	} {
+		_, sErr := seqoperations.Matrix[int](system.m).Solve(system.b)
+		_, cErr := concoperations.Matrix[int](system.m).Solve(system.b)
+		assert.NotNil(t, cErr)
+		assert.Equal(t, sErr, cErr)
+
+		// the same right hand side repeated in two columns
+		b := make([][]int, len(system.b))
+		for i, element := range system.b {
+			b[i] = []int{element, element}
+		}
+		_, sErr = seqoperations.Matrix[int](system.m).SolveMatrix(b)
+		_, cErr = concoperations.Matrix[int](system.m).SolveMatrix(b)
+		assert.NotNil(t, cErr)
+		assert.Equal(t, sErr, cErr)
+	}
+}
@@ -0,0 +1,77 @@
+package concoperations
+
+import (
+	"fmt"
+
+	"github.com/DominicHinton/matrix/internal/textio"
+)
+
+// String returns m as formatted by the %v verb, with each row on its own line and the columns aligned
+func (m Matrix[N]) String() string {
+	return fmt.Sprintf("%v", m)
+}
+
+// Format implements fmt.Formatter. The verb, flags, width and precision are applied to every element,
+// so %.3f prints each element to three decimal places, and each row is printed on its own line with the
+// elements of a column aligned, right justified unless the - flag is given.
+// %+v precedes the matrix with its element type and dimensions and %#v prints the underlying [][]N in Go syntax.
+func (m Matrix[N]) Format(f fmt.State, verb rune) {
+	textio.FormatMatrix(f, verb, m, 0, 0)
+}
+
+// Truncated returns m wrapped so that at most rows rows and columns columns are displayed when formatted.
+// The first and last rows and columns are shown and those left out are replaced by "...".
+// A limit less than 1 leaves that dimension untruncated.
+func (m Matrix[N]) Truncated(rows, columns int) TruncatedMatrix[N] {
+	return TruncatedMatrix[N]{m, rows, columns}
+}
+
+// TruncatedMatrix is a matrix that leaves out the middle rows and columns beyond its limits when formatted
+type TruncatedMatrix[N Number] struct {
+	matrix        Matrix[N]
+	rows, columns int
+}
+
+// String returns t as formatted by the %v verb
+func (t TruncatedMatrix[N]) String() string {
+	return fmt.Sprintf("%v", t)
+}
+
+// Format implements fmt.Formatter in the same way as Matrix.Format
+func (t TruncatedMatrix[N]) Format(f fmt.State, verb rune) {
+	textio.FormatMatrix(f, verb, t.matrix, t.rows, t.columns)
+}
+
+// String returns v as formatted by the %v verb, on a single line
+func (v Vector[N]) String() string {
+	return fmt.Sprintf("%v", v)
+}
+
+// Format implements fmt.Formatter. The verb, flags, width and precision are applied to every element.
+// %+v precedes the vector with its element type and length and %#v prints the underlying []N in Go syntax.
+func (v Vector[N]) Format(f fmt.State, verb rune) {
+	textio.FormatVector(f, verb, v, 0)
+}
+
+// Truncated returns v wrapped so that at most length elements are displayed when formatted.
+// The first and last elements are shown and those left out are replaced by "...".
+// A length less than 1 leaves v untruncated.
+func (v Vector[N]) Truncated(length int) TruncatedVector[N] {
+	return TruncatedVector[N]{v, length}
+}
+
+// TruncatedVector is a vector that leaves out the middle elements beyond its limit when formatted
+type TruncatedVector[N Number] struct {
+	vector Vector[N]
+	length int
+}
+
+// String returns t as formatted by the %v verb
+func (t TruncatedVector[N]) String() string {
+	return fmt.Sprintf("%v", t)
+}
+
+// Format implements fmt.Formatter in the same way as Vector.Format
+func (t TruncatedVector[N]) Format(f fmt.State, verb rune) {
+	textio.FormatVector(f, verb, t.vector, t.length)
+}
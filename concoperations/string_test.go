@@ -0,0 +1,26 @@
+package concoperations_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test String and Format agree with seqoperations
+*/
+
+func TestFormatMatchesSequential(t *testing.T) {
+	m := concoperations.NewMatrixFromSlice(9, 11, []float64{1, -2.5, 300, 0.125, 7})
+	s := seqoperations.Matrix[float64](m)
+	for _, format := range []string{"%v", "%+v", "%#v", "%.3f", "%-8.2e", "%g"} {
+		assert.Equal(t, fmt.Sprintf(format, s), fmt.Sprintf(format, m))
+		assert.Equal(t, fmt.Sprintf(format, s.Truncated(4, 5)), fmt.Sprintf(format, m.Truncated(4, 5)))
+		assert.Equal(t, fmt.Sprintf(format, seqoperations.Vector[float64](m[0])), fmt.Sprintf(format, concoperations.Vector[float64](m[0])))
+	}
+	assert.Equal(t, s.String(), m.String())
+	assert.Equal(t, "[1 2 ... 5]", concoperations.Vector[int]{1, 2, 3, 4, 5}.Truncated(3).String())
+}
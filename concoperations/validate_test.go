@@ -0,0 +1,97 @@
+package concoperations_test
+
+import (
+	"context"
+
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test Validate
+*/
+
+func TestValidate(t *testing.T) {
+	assert.Nil(t, concoperations.Matrix[int]{}.Validate())
+	assert.Nil(t, concoperations.Matrix[int]{{}, {}}.Validate())
+	assert.Nil(t, concoperations.Matrix[int]{{1, 2}, {3, 4}}.Validate())
+	assert.Equal(t, e.ErrRagged, concoperations.Matrix[int]{{1, 2}, {3}}.Validate())
+	assert.Equal(t, e.ErrRagged, concoperations.Matrix[int]{{1}, {2, 3}}.Validate())
+}
+
+/*
+Test operations reject ragged input instead of panicking
+*/
+
+func TestOperationsRejectRaggedInput(t *testing.T) {
+	ragged := concoperations.Matrix[float64]{{1, 2}, {3}}
+	shortFirst := concoperations.Matrix[float64]{{1}, {2, 3}}
+	square := concoperations.Matrix[float64]{{1, 2}, {3, 4}}
+
+	_, err := ragged.AddMatrices(square)
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = square.ApplyOneToOne(ragged, concoperations.Add[float64])
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = ragged.Multiply(square)
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = shortFirst.Multiply(square)
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = ragged.Inverse()
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = ragged.InverseAssumeFloat64Input()
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = ragged.Determinant()
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = ragged.DeterminantAssumeFloat64Input()
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = ragged.SubMatrix(0, 0, 1, 1)
+	assert.Equal(t, e.ErrRagged, err)
+	_, _, _, err = ragged.LU()
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = ragged.Solve(concoperations.Vector[float64]{1, 2})
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = square.SolveMatrix(ragged)
+	assert.Equal(t, e.ErrRagged, err)
+	_, _, err = ragged.RowEchelonContext(context.Background())
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = ragged.MapFunctionToElementsContext(context.Background(), func(x float64) float64 { return x })
+	assert.Equal(t, e.ErrRagged, err)
+}
+
+func TestElementOperationsOnRaggedInput(t *testing.T) {
+	for _, ragged := range []concoperations.Matrix[float64]{{{1, 2}, {3}}, {{1}, {3, 4}}} {
+		square := concoperations.Matrix[float64]{{1, 2}, {3, 4}}
+
+		// operations without an error return give an empty matrix, false or ok=false
+		assert.Equal(t, concoperations.Matrix[float64]{}, ragged.MapFunctionToElements(func(element float64) float64 { return element }))
+		assert.Equal(t, e.ErrRagged, ragged.MapFunctionToElementsInRowInPlace(func(element float64) float64 { return element }, 0))
+		for _, operation := range []func(float64) concoperations.Matrix[float64]{
+			ragged.AddToElements, ragged.SubtractFromElements, ragged.SubtractElementsFrom,
+			ragged.MultiplyElementsBy, ragged.DivideElementsBy, ragged.DivideByElements,
+		} {
+			assert.Equal(t, concoperations.Matrix[float64]{}, operation(2))
+		}
+
+		_, ok := ragged.Mean()
+		assert.False(t, ok)
+		_, _, ok = ragged.MeanStandardDev()
+		assert.False(t, ok)
+		assert.Equal(t, concoperations.Matrix[float64]{}, ragged.Copy())
+		assert.Equal(t, concoperations.Matrix[float64]{}, ragged.Float64Copy())
+		assert.Equal(t, concoperations.Matrix[float64]{}, ragged.SequentialTranspose())
+		assert.False(t, ragged.WithinSigma(square, 1.0))
+		assert.False(t, square.WithinSigma(ragged, 1.0))
+
+		_, _, err := ragged.RowEchelon()
+		assert.Equal(t, e.ErrRagged, err)
+		_, _, err = ragged.ReducedRowEchelon()
+		assert.Equal(t, e.ErrRagged, err)
+		_, err = ragged.Rank()
+		assert.Equal(t, e.ErrRagged, err)
+		_, err = ragged.NullSpace()
+		assert.Equal(t, e.ErrRagged, err)
+	}
+}
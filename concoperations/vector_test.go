@@ -0,0 +1,121 @@
+package concoperations_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/DominicHinton/matrix/concoperations"
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test vector algebra agrees with seqoperations
+*/
+
+func TestVectorElementWiseMatchesSequential(t *testing.T) {
+	withProcs(t, 8, func() {
+		r := rand.New(rand.NewSource(5))
+		values, others := randomFloatVector(20000, r), randomFloatVector(20000, r)
+		c, s := concoperations.Vector[float64](values), seqoperations.Vector[float64](values)
+		cOther, sOther := concoperations.Vector[float64](others), seqoperations.Vector[float64](others)
+
+		cSum, err := c.AddVectors(cOther)
+		assert.Nil(t, err)
+		sSum, _ := s.AddVectors(sOther)
+		assert.Equal(t, []float64(sSum), []float64(cSum))
+		cDifference, err := c.SubtractVectors(cOther)
+		assert.Nil(t, err)
+		sDifference, _ := s.SubtractVectors(sOther)
+		assert.Equal(t, []float64(sDifference), []float64(cDifference))
+		cProduct, err := c.ElementWiseMultiply(cOther)
+		assert.Nil(t, err)
+		sProduct, _ := s.ElementWiseMultiply(sOther)
+		assert.Equal(t, []float64(sProduct), []float64(cProduct))
+
+		_, err = c.AddVectors(cOther[:10])
+		assert.Equal(t, e.ErrDifferentDimension, err)
+	})
+}
+
+func TestVectorGeometryMatchesSequential(t *testing.T) {
+	withProcs(t, 8, func() {
+		r := rand.New(rand.NewSource(9))
+		values, others := randomFloatVector(20000, r), randomFloatVector(20000, r)
+		c, s := concoperations.Vector[float64](values), seqoperations.Vector[float64](values)
+		cOther, sOther := concoperations.Vector[float64](others), seqoperations.Vector[float64](others)
+
+		cUnit, err := c.Normalize()
+		assert.Nil(t, err)
+		sUnit, _ := s.Normalize()
+		assert.InDeltaSlice(t, []float64(sUnit), []float64(cUnit), 1e-15)
+
+		cProjection, err := c.ProjectOnto(cOther)
+		assert.Nil(t, err)
+		sProjection, _ := s.ProjectOnto(sOther)
+		assert.InDeltaSlice(t, []float64(sProjection), []float64(cProjection), 1e-12)
+
+		cAngle, err := c.Angle(cOther)
+		assert.Nil(t, err)
+		sAngle, _ := s.Angle(sOther)
+		assert.InDelta(t, sAngle, cAngle, 1e-12)
+
+		cOuter := c[:300].OuterProduct(cOther[:200])
+		sOuter := s[:300].OuterProduct(sOther[:200])
+		for i := range sOuter {
+			assert.Equal(t, sOuter[i], cOuter[i])
+		}
+
+		_, err = concoperations.Vector[float64]{0, 0}.Normalize()
+		assert.Equal(t, e.ErrZeroNorm, err)
+		_, err = c.ProjectOnto(make(concoperations.Vector[float64], 20000))
+		assert.Equal(t, e.ErrZeroNorm, err)
+		_, err = c.Angle(cOther[:3])
+		assert.Equal(t, e.ErrDifferentDimension, err)
+	})
+}
+
+func TestCrossProduct(t *testing.T) {
+	w, err := concoperations.Vector[int]{2, 3, 4}.CrossProduct(concoperations.Vector[int]{5, 6, 7})
+	assert.Nil(t, err)
+	assert.Equal(t, concoperations.Vector[int]{-3, 6, -3}, w)
+
+	_, err = concoperations.Vector[int]{1, 2}.CrossProduct(concoperations.Vector[int]{1, 2, 3})
+	assert.Equal(t, e.ErrNotThreeDimensional, err)
+
+	angle, _ := concoperations.Vector[float64]{1, 0}.Angle(concoperations.Vector[float64]{0, 3})
+	assert.InDelta(t, math.Pi/2, angle, 1e-15)
+}
+
+func TestSmallAngleMatchesSequential(t *testing.T) {
+	withProcs(t, 8, func() {
+		// a long vector of ones and a copy with one element nudged by delta are nearly parallel,
+		// with sin(theta) = delta * sqrt(n - 1) / (sqrt(n) * |u|)
+		n, delta := 20000, 1e-7
+		values := make([]float64, n)
+		for k := range values {
+			values[k] = 1.0
+		}
+		nudged := append([]float64{}, values...)
+		nudged[0] += delta
+		expected := math.Asin(delta * math.Sqrt(float64(n-1)) / (math.Sqrt(float64(n)) * math.Sqrt(float64(n)+2*delta+delta*delta)))
+
+		cAngle, err := concoperations.Vector[float64](values).Angle(nudged)
+		assert.Nil(t, err)
+		sAngle, _ := seqoperations.Vector[float64](values).Angle(nudged)
+		assert.InDelta(t, expected, cAngle, 1e-7*expected)
+		assert.InDelta(t, expected, sAngle, 1e-7*expected)
+
+		cDistance, err := concoperations.Vector[float64](values).CosineDistance(nudged)
+		assert.Nil(t, err)
+		sDistance, _ := seqoperations.Vector[float64](values).CosineDistance(nudged)
+		assert.InDelta(t, expected*expected/2, cDistance, 1e-6*cDistance)
+		assert.InDelta(t, expected*expected/2, sDistance, 1e-6*sDistance)
+	})
+
+	angle, err := concoperations.Vector[float64]{1, 0}.Angle(concoperations.Vector[float64]{1, 1e-9})
+	assert.Nil(t, err)
+	assert.InDelta(t, 1e-9, angle, 1e-21)
+}
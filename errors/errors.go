@@ -1,14 +1,44 @@
 package errors
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrDifferentDimension      = errors.New("matrices must be of same dimension")
+	ErrInvalidDelimiter        = errors.New("delimiter cannot be used to separate fields of delimited text")
 	ErrMultiplicationValidity  = errors.New("matrices of these dimensions cannot be multiplied in this order")
 	ErrNonSquare               = errors.New("i and j values are not equal, this matrix should be square")
+	ErrNoConvergence           = errors.New("iterative method did not converge within the iteration limit")
 	ErrNoInverse               = errors.New("no inverse exists for this matrix")
+	ErrNormOrder               = errors.New("norm order must be at least 1")
 	ErrNotFloat64              = errors.New("this method's assumption of float64 matrix input was not satisfied")
+	ErrNotPositiveDefinite     = errors.New("matrix is not symmetric positive definite")
+	ErrNotSymmetric            = errors.New("matrix is not symmetric")
+	ErrNotThreeDimensional     = errors.New("cross product is only defined for vectors of length 3")
+	ErrRagged                  = errors.New("matrix rows are not all the same length")
 	ErrRowColSuppliedOutBounds = errors.New("row or column number out of bounds")
+	ErrSparseFormat            = errors.New("sparse format must be CSR or CSC")
+	ErrUnderdetermined         = errors.New("system has fewer equations than unknowns")
 	ErrUnexpected              = errors.New("unexpected error occurred")
 	ErrZeroLength              = errors.New("matrix has no rows")
+	ErrZeroNorm                = errors.New("vector has zero norm")
 )
+
+// ParseError reports the position in delimited input of a value that could not be read into a matrix.
+// Line and Column are 1-based, with Column counting fields rather than bytes.
+// Err is the underlying cause, such as a *strconv.NumError or ErrRagged.
+type ParseError struct {
+	Line   int
+	Column int
+	Err    error
+}
+
+func (p *ParseError) Error() string {
+	return fmt.Sprintf("line %d, column %d: %v", p.Line, p.Column, p.Err)
+}
+
+func (p *ParseError) Unwrap() error {
+	return p.Err
+}
@@ -0,0 +1,188 @@
+package textio
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/types"
+)
+
+// csvSettings holds the layout of delimited text read by ReadCSV and written by WriteCSV
+type csvSettings struct {
+	delimiter   rune
+	header      bool
+	columnNames []string
+	err         error
+}
+
+// CSVOption adjusts the layout of delimited text read by ReadCSV and written by WriteCSV
+type CSVOption func(*csvSettings)
+
+// WithDelimiter sets the rune separating fields, which is a comma by default.
+// ReadCSV and WriteCSV return ErrInvalidDelimiter for a delimiter that encoding/csv cannot use,
+// such as a quote or newline.
+func WithDelimiter(delimiter rune) CSVOption {
+	return func(s *csvSettings) {
+		s.delimiter, s.err = delimiter, nil
+		if delimiter == 0 || delimiter == '"' || delimiter == '\r' || delimiter == '\n' || delimiter == utf8.RuneError || !utf8.ValidRune(delimiter) {
+			s.err = e.ErrInvalidDelimiter
+		}
+	}
+}
+
+// WithHeader makes ReadCSV skip the first record, which holds column names rather than values
+func WithHeader() CSVOption {
+	return func(s *csvSettings) {
+		s.header = true
+	}
+}
+
+// WithColumnNames makes WriteCSV write names as a header record before the values
+func WithColumnNames(names ...string) CSVOption {
+	return func(s *csvSettings) {
+		s.columnNames = names
+	}
+}
+
+// newCSVSettings returns the default settings with each option applied in turn,
+// or the error recorded by an option that could not be applied
+func newCSVSettings(options []CSVOption) (csvSettings, error) {
+	settings := csvSettings{delimiter: ','}
+	for _, option := range options {
+		option(&settings)
+	}
+	return settings, settings.err
+}
+
+// ReadCSV reads the rows of a matrix from delimited text in r, one row per record.
+// Fields are trimmed of surrounding spaces and parsed as N, so that a float read into an integer matrix or
+// a value outside the range of N is an error rather than being truncated. A record with a different number
+// of fields from the first wraps ErrRagged. Both are returned as an *errors.ParseError giving the line and
+// column of the field, while malformed quoting is reported by encoding/csv as a *csv.ParseError.
+// Empty input gives no rows and ErrInvalidDelimiter is returned if the delimiter cannot separate fields.
+func ReadCSV[N types.Number](r io.Reader, options []CSVOption) ([][]N, error) {
+	settings, err := newCSVSettings(options)
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(r)
+	reader.Comma = settings.delimiter
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	m := [][]N{}
+	for skip := settings.header; ; skip = false {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return m, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if skip {
+			continue
+		}
+		if len(m) > 0 && len(record) != len(m[0]) {
+			column := len(record)
+			if len(m[0]) < column {
+				column = len(m[0])
+			}
+			line, _ := reader.FieldPos(0)
+			return nil, &e.ParseError{Line: line, Column: column + 1, Err: e.ErrRagged}
+		}
+		row := make([]N, len(record))
+		for j, field := range record {
+			value, err := parseNumber[N](strings.TrimSpace(field))
+			if err != nil {
+				line, _ := reader.FieldPos(j)
+				return nil, &e.ParseError{Line: line, Column: j + 1, Err: err}
+			}
+			row[j] = value
+		}
+		m = append(m, row)
+	}
+}
+
+// WriteCSV writes the rows of m to w as delimited text, one record per row.
+// Integers are written in base 10 and floats in the shortest form that reads back as the same value.
+// ErrDifferentDimension is returned if a row or the supplied column names do not match the length
+// of the first row of m, and ErrInvalidDelimiter if the delimiter cannot separate fields.
+func WriteCSV[N types.Number](w io.Writer, m [][]N, options []CSVOption) error {
+	settings, err := newCSVSettings(options)
+	if err != nil {
+		return err
+	}
+	columns := 0
+	if len(m) > 0 {
+		columns = len(m[0])
+	}
+	if settings.columnNames != nil && len(settings.columnNames) != columns {
+		return e.ErrDifferentDimension
+	}
+
+	writer := csv.NewWriter(w)
+	writer.Comma = settings.delimiter
+	if settings.columnNames != nil {
+		if err := writer.Write(settings.columnNames); err != nil {
+			return err
+		}
+	}
+	record := make([]string, columns)
+	for _, row := range m {
+		if len(row) != columns {
+			return e.ErrDifferentDimension
+		}
+		for j, element := range row {
+			record[j] = formatNumber(element)
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// parseNumber parses s as a value of type N, returning a *strconv.NumError if s is not valid or out of range
+func parseNumber[N types.Number](s string) (N, error) {
+	switch any(N(0)).(type) {
+	case float32, float64:
+		x, err := strconv.ParseFloat(s, bitSize[N]())
+		return N(x), err
+	case uint, uint8, uint16, uint32, uint64:
+		x, err := strconv.ParseUint(s, 10, bitSize[N]())
+		return N(x), err
+	}
+	x, err := strconv.ParseInt(s, 10, bitSize[N]())
+	return N(x), err
+}
+
+// formatNumber returns x as text that parseNumber reads back as x
+func formatNumber[N types.Number](x N) string {
+	switch any(x).(type) {
+	case float32, float64:
+		return strconv.FormatFloat(float64(x), 'g', -1, bitSize[N]())
+	case uint, uint8, uint16, uint32, uint64:
+		return strconv.FormatUint(uint64(x), 10)
+	}
+	return strconv.FormatInt(int64(x), 10)
+}
+
+// bitSize returns the number of bits used to store a value of type N
+func bitSize[N types.Number]() int {
+	switch any(N(0)).(type) {
+	case int8, uint8:
+		return 8
+	case int16, uint16:
+		return 16
+	case int32, uint32, float32:
+		return 32
+	case int, uint:
+		return strconv.IntSize
+	}
+	return 64
+}
@@ -0,0 +1,25 @@
+package textio_test
+
+import (
+	"bytes"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/internal/textio"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test WriteCSV
+*/
+
+func TestWriteCSVRejectsRaggedRows(t *testing.T) {
+	for _, ragged := range [][][]int{{{1, 2}, {3}}, {{1}, {2, 3}}} {
+		var b bytes.Buffer
+		assert.Equal(t, e.ErrDifferentDimension, textio.WriteCSV(&b, ragged, nil))
+	}
+
+	var b bytes.Buffer
+	assert.Nil(t, textio.WriteCSV(&b, [][]int{{1, 2}, {3, 4}}, nil))
+	assert.Equal(t, "1,2\n3,4\n", b.String())
+}
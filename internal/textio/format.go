@@ -0,0 +1,158 @@
+// Package textio formats matrices and vectors as text and reads and writes them as delimited text,
+// for use by both seqoperations and concoperations.
+package textio
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/DominicHinton/matrix/types"
+)
+
+// ellipsis replaces the rows, columns or elements left out of a truncated display
+const ellipsis = "..."
+
+// FormatMatrix writes m to f showing at most maxRows rows and maxColumns columns, where a limit less than 1
+// means no limit. Ragged rows are padded with blanks rather than rejected, so that they can still be inspected.
+func FormatMatrix[N types.Number](f fmt.State, verb rune, m [][]N, maxRows, maxColumns int) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "%#v", m)
+		return
+	}
+	rows, columns := len(m), 0
+	for _, row := range m {
+		if len(row) > columns {
+			columns = len(row)
+		}
+	}
+	if verb == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, "Matrix[%T] %dx%d:\n", N(0), rows, columns)
+	}
+	if rows == 0 {
+		fmt.Fprint(f, "[]")
+		return
+	}
+
+	format := elementFormat(f, verb)
+	rowIndices, columnIndices := shownIndices(rows, maxRows), shownIndices(columns, maxColumns)
+	cells := make([][]string, len(rowIndices))
+	widths := make([]int, len(columnIndices))
+	for r, i := range rowIndices {
+		if i < 0 {
+			continue
+		}
+		cells[r] = make([]string, len(columnIndices))
+		for c, j := range columnIndices {
+			switch {
+			case j < 0:
+				cells[r][c] = ellipsis
+			case j < len(m[i]):
+				cells[r][c] = fmt.Sprintf(format, m[i][j])
+			}
+			if len(cells[r][c]) > widths[c] {
+				widths[c] = len(cells[r][c])
+			}
+		}
+	}
+
+	leftJustify := f.Flag('-')
+	var b strings.Builder
+	for r, row := range cells {
+		if r > 0 {
+			b.WriteByte('\n')
+		}
+		if row == nil {
+			b.WriteString(ellipsis)
+			continue
+		}
+		b.WriteByte('[')
+		for c, cell := range row {
+			if c > 0 {
+				b.WriteByte(' ')
+			}
+			writePadded(&b, cell, widths[c], leftJustify)
+		}
+		b.WriteByte(']')
+	}
+	fmt.Fprint(f, b.String())
+}
+
+// FormatVector writes v to f showing at most maxLength elements, where a limit less than 1 means no limit
+func FormatVector[N types.Number](f fmt.State, verb rune, v []N, maxLength int) {
+	if verb == 'v' && f.Flag('#') {
+		fmt.Fprintf(f, "%#v", v)
+		return
+	}
+	if verb == 'v' && f.Flag('+') {
+		fmt.Fprintf(f, "Vector[%T] %d: ", N(0), len(v))
+	}
+	format := elementFormat(f, verb)
+	var b strings.Builder
+	b.WriteByte('[')
+	for k, i := range shownIndices(len(v), maxLength) {
+		if k > 0 {
+			b.WriteByte(' ')
+		}
+		if i < 0 {
+			b.WriteString(ellipsis)
+		} else {
+			fmt.Fprintf(&b, format, v[i])
+		}
+	}
+	b.WriteByte(']')
+	fmt.Fprint(f, b.String())
+}
+
+// elementFormat rebuilds the format directive given to Format so that it can be applied to each element.
+// The + and # flags are left out for the v verb, where they select the matrix or vector layout instead.
+func elementFormat(f fmt.State, verb rune) string {
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) && !(verb == 'v' && (flag == '+' || flag == '#')) {
+			b.WriteRune(flag)
+		}
+	}
+	if width, ok := f.Width(); ok {
+		b.WriteString(strconv.Itoa(width))
+	}
+	if precision, ok := f.Precision(); ok {
+		b.WriteByte('.')
+		b.WriteString(strconv.Itoa(precision))
+	}
+	b.WriteRune(verb)
+	return b.String()
+}
+
+// shownIndices returns the indices of [0, length) to display when at most limit may be shown.
+// If some are left out the first half of limit and the last half are returned, separated by -1.
+func shownIndices(length, limit int) []int {
+	if limit < 1 || length <= limit {
+		limit = length
+	}
+	head := (limit + 1) / 2
+	indices := make([]int, 0, limit+1)
+	for i := 0; i < head; i++ {
+		indices = append(indices, i)
+	}
+	if limit < length {
+		indices = append(indices, -1)
+	}
+	for i := length - (limit - head); i < length; i++ {
+		indices = append(indices, i)
+	}
+	return indices
+}
+
+// writePadded writes s to b padded with spaces to width, on the left unless leftJustify is true
+func writePadded(b *strings.Builder, s string, width int, leftJustify bool) {
+	padding := strings.Repeat(" ", width-len(s))
+	if leftJustify {
+		b.WriteString(s)
+		b.WriteString(padding)
+		return
+	}
+	b.WriteString(padding)
+	b.WriteString(s)
+}
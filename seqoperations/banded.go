@@ -0,0 +1,364 @@
+package seqoperations
+
+import "math"
+
+// Tridiagonal is a square matrix whose only non-zero elements lie on the main diagonal and the
+// diagonals directly above and below it, stored as three vectors.
+type Tridiagonal[N Number] struct {
+	lower, diagonal, upper Vector[N]
+}
+
+// NewTridiagonal returns the n x n tridiagonal matrix with the supplied diagonals, where diagonal
+// holds the n elements of the main diagonal and lower and upper the n-1 elements below and above it.
+// The vectors are copied. errZeroLength is returned if diagonal is empty and errDifferentDimension
+// if lower or upper is not one element shorter than diagonal.
+func NewTridiagonal[N Number](lower, diagonal, upper Vector[N]) (Tridiagonal[N], error) {
+	dimension := len(diagonal)
+	if dimension == 0 {
+		return Tridiagonal[N]{}, errZeroLength
+	}
+	if len(lower) != dimension-1 || len(upper) != dimension-1 {
+		return Tridiagonal[N]{}, errDifferentDimension
+	}
+	return Tridiagonal[N]{
+		lower:    append(Vector[N]{}, lower...),
+		diagonal: append(Vector[N]{}, diagonal...),
+		upper:    append(Vector[N]{}, upper...),
+	}, nil
+}
+
+// Dimensions returns the number of rows and columns of t
+func (t Tridiagonal[N]) Dimensions() (int, int) {
+	return len(t.diagonal), len(t.diagonal)
+}
+
+// At returns element (i, j) of t, which is zero away from the three diagonals.
+// At panics if i or j is out of range.
+func (t Tridiagonal[N]) At(i, j int) N {
+	dimension := len(t.diagonal)
+	if i < 0 || i >= dimension || j < 0 || j >= dimension {
+		panic(errRowColSuppliedOutBounds)
+	}
+	switch j - i {
+	case -1:
+		return t.lower[j]
+	case 0:
+		return t.diagonal[i]
+	case 1:
+		return t.upper[i]
+	}
+	return N(0)
+}
+
+// Matrix returns t as a Matrix with every element stored
+func (t Tridiagonal[N]) Matrix() Matrix[N] {
+	dimension := len(t.diagonal)
+	m := NewZeroMatrix[N](dimension, dimension)
+	for i := 0; i < dimension; i++ {
+		m[i][i] = t.diagonal[i]
+		if i > 0 {
+			m[i][i-1] = t.lower[i-1]
+			m[i-1][i] = t.upper[i-1]
+		}
+	}
+	return m
+}
+
+// MultiplyVector returns the vector t * v.
+// errMultiplicationValidity is returned if the length of v does not match the dimension of t.
+func (t Tridiagonal[N]) MultiplyVector(v Vector[N]) (Vector[N], error) {
+	dimension := len(t.diagonal)
+	if len(v) != dimension {
+		return Vector[N]{}, errMultiplicationValidity
+	}
+	out := NewZeroVector[N](dimension)
+	for i := 0; i < dimension; i++ {
+		out[i] = t.diagonal[i] * v[i]
+		if i > 0 {
+			out[i] += t.lower[i-1] * v[i-1]
+		}
+		if i < dimension-1 {
+			out[i] += t.upper[i] * v[i+1]
+		}
+	}
+	return out, nil
+}
+
+// ApplyFloat64 returns the product of t and x, allowing a Tridiagonal to be used as a LinearOperator.
+// errMultiplicationValidity is returned if the length of x does not match the dimension of t.
+func (t Tridiagonal[N]) ApplyFloat64(x Vector[float64]) (Vector[float64], error) {
+	dimension := len(t.diagonal)
+	if len(x) != dimension {
+		return Vector[float64]{}, errMultiplicationValidity
+	}
+	out := make(Vector[float64], dimension)
+	for i := 0; i < dimension; i++ {
+		out[i] = float64(t.diagonal[i]) * x[i]
+		if i > 0 {
+			out[i] += float64(t.lower[i-1]) * x[i-1]
+		}
+		if i < dimension-1 {
+			out[i] += float64(t.upper[i]) * x[i+1]
+		}
+	}
+	return out, nil
+}
+
+// DiagonalFloat64 returns the main diagonal of t as float64 values
+func (t Tridiagonal[N]) DiagonalFloat64() (Vector[float64], error) {
+	diagonal := make(Vector[float64], len(t.diagonal))
+	for i, element := range t.diagonal {
+		diagonal[i] = float64(element)
+	}
+	return diagonal, nil
+}
+
+// Solve returns the vector x satisfying T * x = b in O(n) operations using the Thomas algorithm,
+// which is Gaussian elimination without pivoting. It is stable for diagonally dominant and
+// symmetric positive definite matrices; use Banded.Solve, which pivots, for other matrices.
+// errMultiplicationValidity is returned if the length of b does not match the dimension of t
+// and errNoInverse is returned if elimination meets a pivot that is zero to working precision.
+func (t Tridiagonal[N]) Solve(b Vector[N]) (Vector[float64], error) {
+	dimension := len(t.diagonal)
+	if dimension == 0 {
+		return Vector[float64]{}, errZeroLength
+	}
+	if len(b) != dimension {
+		return Vector[float64]{}, errMultiplicationValidity
+	}
+
+	// forward sweep eliminates the lower diagonal, leaving a unit upper bidiagonal system
+	upper := make([]float64, dimension)
+	x := make(Vector[float64], dimension)
+	for i := 0; i < dimension; i++ {
+		pivot, rhs := float64(t.diagonal[i]), float64(b[i])
+		if i > 0 {
+			lower := float64(t.lower[i-1])
+			pivot -= lower * upper[i-1]
+			rhs -= lower * x[i-1]
+		}
+		if math.Abs(pivot) <= t.rowTolerance(i) {
+			return Vector[float64]{}, errNoInverse
+		}
+		if i < dimension-1 {
+			upper[i] = float64(t.upper[i]) / pivot
+		}
+		x[i] = rhs / pivot
+	}
+
+	for i := dimension - 2; i >= 0; i-- {
+		x[i] -= upper[i] * x[i+1]
+	}
+	return x, nil
+}
+
+// rowTolerance returns the magnitude below which a pivot in row i of t is treated as zero,
+// scaled by the number of elements in the row and its largest element
+func (t Tridiagonal[N]) rowTolerance(i int) float64 {
+	count, largest := 1, math.Abs(float64(t.diagonal[i]))
+	if i > 0 {
+		count, largest = count+1, math.Max(largest, math.Abs(float64(t.lower[i-1])))
+	}
+	if i < len(t.upper) {
+		count, largest = count+1, math.Max(largest, math.Abs(float64(t.upper[i])))
+	}
+	return float64(count) * largest * machineEpsilon
+}
+
+// Banded is a square matrix whose non-zero elements lie within a fixed number of diagonals below
+// and above the main diagonal. Each row stores only the elements within the band, so an n x n matrix
+// with lower and upper bandwidths kl and ku holds n * (kl + ku + 1) elements.
+type Banded[N Number] struct {
+	dimension, lower, upper int
+	data                    []N
+}
+
+// NewBanded returns an n x n Banded with lower diagonals below and upper diagonals above the main
+// diagonal, with all elements zero. errZeroLength is returned if n is not positive and
+// errRowColSuppliedOutBounds if a bandwidth is negative.
+func NewBanded[N Number](n, lower, upper int) (Banded[N], error) {
+	if n <= 0 {
+		return Banded[N]{}, errZeroLength
+	}
+	if lower < 0 || upper < 0 {
+		return Banded[N]{}, errRowColSuppliedOutBounds
+	}
+	return Banded[N]{dimension: n, lower: lower, upper: upper, data: make([]N, n*(lower+upper+1))}, nil
+}
+
+// Dimensions returns the number of rows and columns of b
+func (b Banded[N]) Dimensions() (int, int) {
+	return b.dimension, b.dimension
+}
+
+// Bandwidths returns the number of diagonals stored below and above the main diagonal
+func (b Banded[N]) Bandwidths() (int, int) {
+	return b.lower, b.upper
+}
+
+// At returns element (i, j) of b, which is zero outside the band.
+// At panics if i or j is out of range.
+func (b Banded[N]) At(i, j int) N {
+	if i < 0 || i >= b.dimension || j < 0 || j >= b.dimension {
+		panic(errRowColSuppliedOutBounds)
+	}
+	if j-i < -b.lower || j-i > b.upper {
+		return N(0)
+	}
+	return b.data[b.index(i, j)]
+}
+
+// Set sets element (i, j) of b to value. Set panics if (i, j) is outside the matrix or the band.
+func (b Banded[N]) Set(i, j int, value N) {
+	if i < 0 || i >= b.dimension || j < 0 || j >= b.dimension || j-i < -b.lower || j-i > b.upper {
+		panic(errRowColSuppliedOutBounds)
+	}
+	b.data[b.index(i, j)] = value
+}
+
+// Matrix returns b as a Matrix with every element stored
+func (b Banded[N]) Matrix() Matrix[N] {
+	m := NewZeroMatrix[N](b.dimension, b.dimension)
+	for i := 0; i < b.dimension; i++ {
+		start, end := b.bandColumns(i)
+		for j := start; j < end; j++ {
+			m[i][j] = b.data[b.index(i, j)]
+		}
+	}
+	return m
+}
+
+// MultiplyVector returns the vector b * v.
+// errMultiplicationValidity is returned if the length of v does not match the dimension of b.
+func (b Banded[N]) MultiplyVector(v Vector[N]) (Vector[N], error) {
+	if len(v) != b.dimension {
+		return Vector[N]{}, errMultiplicationValidity
+	}
+	out := NewZeroVector[N](b.dimension)
+	for i := 0; i < b.dimension; i++ {
+		start, end := b.bandColumns(i)
+		for j := start; j < end; j++ {
+			out[i] += b.data[b.index(i, j)] * v[j]
+		}
+	}
+	return out, nil
+}
+
+// ApplyFloat64 returns the product of b and x, allowing a Banded to be used as a LinearOperator.
+// errMultiplicationValidity is returned if the length of x does not match the dimension of b.
+func (b Banded[N]) ApplyFloat64(x Vector[float64]) (Vector[float64], error) {
+	if len(x) != b.dimension {
+		return Vector[float64]{}, errMultiplicationValidity
+	}
+	out := make(Vector[float64], b.dimension)
+	for i := 0; i < b.dimension; i++ {
+		start, end := b.bandColumns(i)
+		for j := start; j < end; j++ {
+			out[i] += float64(b.data[b.index(i, j)]) * x[j]
+		}
+	}
+	return out, nil
+}
+
+// DiagonalFloat64 returns the main diagonal of b as float64 values
+func (b Banded[N]) DiagonalFloat64() (Vector[float64], error) {
+	diagonal := make(Vector[float64], b.dimension)
+	for i := range diagonal {
+		diagonal[i] = float64(b.data[b.index(i, i)])
+	}
+	return diagonal, nil
+}
+
+// Solve returns the vector x satisfying B * x = rhs using LU decomposition with partial pivoting
+// restricted to the band, which takes O(n * kl * (kl + ku)) operations rather than O(n^3).
+// Row exchanges widen the upper band of U to kl + ku diagonals, so the factorisation is made in
+// a copy with room for them.
+// errMultiplicationValidity is returned if the length of rhs does not match the dimension of b
+// and errNoInverse is returned if b is singular.
+func (b Banded[N]) Solve(rhs Vector[N]) (Vector[float64], error) {
+	if b.dimension == 0 {
+		return Vector[float64]{}, errZeroLength
+	}
+	if len(rhs) != b.dimension {
+		return Vector[float64]{}, errMultiplicationValidity
+	}
+
+	// work holds rows of the factorisation from column i-kl to i+kl+ku
+	n, lower, upper := b.dimension, b.lower, b.lower+b.upper
+	width := lower + upper + 1
+	work := make([]float64, n*width)
+	at := func(i, j int) *float64 { return &work[i*width+j-i+lower] }
+	// pivots are measured against the band of the row they came from, so rows track their origin
+	tolerances, origins := make([]float64, n), make([]int, n)
+	for i := 0; i < n; i++ {
+		start, end := b.bandColumns(i)
+		largest := 0.0
+		for j := start; j < end; j++ {
+			*at(i, j) = float64(b.data[b.index(i, j)])
+			largest = math.Max(largest, math.Abs(*at(i, j)))
+		}
+		tolerances[i], origins[i] = float64(end-start)*largest*machineEpsilon, i
+	}
+
+	x := make(Vector[float64], n)
+	for i := range rhs {
+		x[i] = float64(rhs[i])
+	}
+	for k := 0; k < n; k++ {
+		lastRow, lastColumn := minInt(n-1, k+lower), minInt(n-1, k+upper)
+
+		pivot := k
+		for i := k + 1; i <= lastRow; i++ {
+			if math.Abs(*at(i, k)) > math.Abs(*at(pivot, k)) {
+				pivot = i
+			}
+		}
+		if math.Abs(*at(pivot, k)) <= tolerances[origins[pivot]] {
+			return Vector[float64]{}, errNoInverse
+		}
+		if pivot != k {
+			for j := k; j <= lastColumn; j++ {
+				*at(k, j), *at(pivot, j) = *at(pivot, j), *at(k, j)
+			}
+			x[k], x[pivot] = x[pivot], x[k]
+			origins[k], origins[pivot] = origins[pivot], origins[k]
+		}
+
+		for i := k + 1; i <= lastRow; i++ {
+			factor := *at(i, k) / *at(k, k)
+			if factor == 0.0 {
+				continue
+			}
+			for j := k + 1; j <= lastColumn; j++ {
+				*at(i, j) -= factor * *at(k, j)
+			}
+			x[i] -= factor * x[k]
+		}
+	}
+
+	for i := n - 1; i >= 0; i-- {
+		total := x[i]
+		for j := i + 1; j <= minInt(n-1, i+upper); j++ {
+			total -= *at(i, j) * x[j]
+		}
+		x[i] = total / *at(i, i)
+	}
+	return x, nil
+}
+
+// index returns the position of element (i, j), which must lie within the band, in the data of b
+func (b Banded[N]) index(i, j int) int {
+	return i*(b.lower+b.upper+1) + j - i + b.lower
+}
+
+// bandColumns returns the range of columns of row i that lie within both the band and the matrix
+func (b Banded[N]) bandColumns(i int) (int, int) {
+	start, end := i-b.lower, i+b.upper+1
+	if start < 0 {
+		start = 0
+	}
+	if end > b.dimension {
+		end = b.dimension
+	}
+	return start, end
+}
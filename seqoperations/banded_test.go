@@ -0,0 +1,169 @@
+package seqoperations_test
+
+import (
+	"math/rand"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test Tridiagonal
+*/
+
+func TestTridiagonal(t *testing.T) {
+	tri, err := seqoperations.NewTridiagonal(
+		seqoperations.Vector[int]{1, 2, 3},
+		seqoperations.Vector[int]{4, 5, 6, 7},
+		seqoperations.Vector[int]{8, 9, 10},
+	)
+	assert.Nil(t, err)
+	expected := seqoperations.Matrix[int]{
+		{4, 8, 0, 0},
+		{1, 5, 9, 0},
+		{0, 2, 6, 10},
+		{0, 0, 3, 7},
+	}
+	assert.Equal(t, expected, tri.Matrix())
+	assert.Equal(t, 9, tri.At(1, 2))
+	assert.Equal(t, 0, tri.At(3, 0))
+	assert.Panics(t, func() { tri.At(4, 0) })
+
+	v := seqoperations.Vector[int]{1, -1, 2, 3}
+	product, err := tri.MultiplyVector(v)
+	assert.Nil(t, err)
+	dense, _ := expected.Multiply(seqoperations.Matrix[int]{{1}, {-1}, {2}, {3}})
+	for i := range product {
+		assert.Equal(t, dense[i][0], product[i])
+	}
+	_, err = tri.MultiplyVector(v[:3])
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+
+	_, err = seqoperations.NewTridiagonal(seqoperations.Vector[int]{1}, seqoperations.Vector[int]{1, 2}, seqoperations.Vector[int]{})
+	assert.Equal(t, e.ErrDifferentDimension, err)
+	_, err = seqoperations.NewTridiagonal[int](nil, nil, nil)
+	assert.Equal(t, e.ErrZeroLength, err)
+}
+
+func TestTridiagonalSolve(t *testing.T) {
+	dimension := 200
+	lower, diagonal, upper := make(seqoperations.Vector[float64], dimension-1), make(seqoperations.Vector[float64], dimension), make(seqoperations.Vector[float64], dimension-1)
+	for i := range diagonal {
+		diagonal[i] = 2.0
+		if i < dimension-1 {
+			lower[i], upper[i] = -1.0, -1.0
+		}
+	}
+	tri, _ := seqoperations.NewTridiagonal(lower, diagonal, upper)
+	b := rightHandSide(dimension)
+
+	x, err := tri.Solve(b)
+	assert.Nil(t, err)
+	assertSolves(t, tri.Matrix(), x, b, 1e-10)
+
+	// Tridiagonal is a LinearOperator so may also be solved iteratively
+	xCG, _, _, err := seqoperations.JacobiConjugateGradient(tri, b, seqoperations.WithMaxIterations(500), seqoperations.WithTolerance(1e-10))
+	assert.Nil(t, err)
+	assertSolves(t, tri.Matrix(), xCG, b, 1e-9)
+
+	_, err = tri.Solve(b[:3])
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+
+	singular, _ := seqoperations.NewTridiagonal(seqoperations.Vector[float64]{1}, seqoperations.Vector[float64]{1, 1}, seqoperations.Vector[float64]{1})
+	_, err = singular.Solve(seqoperations.Vector[float64]{1, 1})
+	assert.Equal(t, e.ErrNoInverse, err)
+}
+
+/*
+Test Banded
+*/
+
+func TestBanded(t *testing.T) {
+	banded, err := seqoperations.NewBanded[int](4, 1, 2)
+	assert.Nil(t, err)
+	lower, upper := banded.Bandwidths()
+	assert.Equal(t, 1, lower)
+	assert.Equal(t, 2, upper)
+
+	expected := seqoperations.Matrix[int]{
+		{1, 2, 3, 0},
+		{4, 5, 6, 7},
+		{0, 8, 9, 10},
+		{0, 0, 11, 12},
+	}
+	for i := range expected {
+		for j := range expected[i] {
+			if expected[i][j] != 0 {
+				banded.Set(i, j, expected[i][j])
+			}
+		}
+	}
+	assert.Equal(t, expected, banded.Matrix())
+	assert.Equal(t, 0, banded.At(3, 0))
+	assert.Panics(t, func() { banded.Set(3, 0, 1) })
+	assert.Panics(t, func() { banded.At(0, 4) })
+
+	v := seqoperations.Vector[int]{2, -1, 3, 1}
+	product, err := banded.MultiplyVector(v)
+	assert.Nil(t, err)
+	dense, _ := expected.Multiply(seqoperations.Matrix[int]{{2}, {-1}, {3}, {1}})
+	for i := range product {
+		assert.Equal(t, dense[i][0], product[i])
+	}
+
+	_, err = seqoperations.NewBanded[int](0, 1, 1)
+	assert.Equal(t, e.ErrZeroLength, err)
+	_, err = seqoperations.NewBanded[int](3, -1, 1)
+	assert.Equal(t, e.ErrRowColSuppliedOutBounds, err)
+}
+
+func TestBandedSolveNeedsPivoting(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	for _, bandwidths := range [][2]int{{1, 1}, {2, 1}, {1, 3}, {3, 2}} {
+		dimension := 60
+		banded, _ := seqoperations.NewBanded[float64](dimension, bandwidths[0], bandwidths[1])
+		for i := 0; i < dimension; i++ {
+			for j := i - bandwidths[0]; j <= i+bandwidths[1]; j++ {
+				if j >= 0 && j < dimension {
+					banded.Set(i, j, r.Float64()*2-1)
+				}
+			}
+			// small diagonal elements force row exchanges
+			banded.Set(i, i, 1e-3*r.Float64())
+		}
+		b := rightHandSide(dimension)
+
+		x, err := banded.Solve(b)
+		assert.Nil(t, err)
+		assertSolves(t, banded.Matrix(), x, b, 1e-8)
+
+		expected, err := banded.Matrix().Solve(b)
+		assert.Nil(t, err)
+		assert.InDeltaSlice(t, expected, x, 1e-6)
+	}
+
+	singular, _ := seqoperations.NewBanded[float64](3, 1, 1)
+	singular.Set(0, 0, 1)
+	singular.Set(1, 1, 1)
+	_, err := singular.Solve(seqoperations.Vector[float64]{1, 1, 1})
+	assert.Equal(t, e.ErrNoInverse, err)
+}
+
+func TestBadlyScaledBandedSystemsAreSolved(t *testing.T) {
+	// each pivot is only small relative to the other row, which does not make the matrix singular
+	b := seqoperations.Vector[float64]{1e10, 1e-10}
+
+	tri, _ := seqoperations.NewTridiagonal(seqoperations.Vector[float64]{0}, seqoperations.Vector[float64]{1e10, 1e-10}, seqoperations.Vector[float64]{0})
+	x, err := tri.Solve(b)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, seqoperations.Vector[float64]{1, 1}, x, 1e-12)
+
+	banded, _ := seqoperations.NewBanded[float64](2, 1, 1)
+	banded.Set(0, 0, 1e10)
+	banded.Set(1, 1, 1e-10)
+	x, err = banded.Solve(b)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, seqoperations.Vector[float64]{1, 1}, x, 1e-12)
+}
@@ -0,0 +1,80 @@
+package seqoperations
+
+import "math"
+
+// Cholesky returns the lower triangular matrix L satisfying M = L * transpose(L)
+// for a symmetric positive definite matrix M.
+// errNotPositiveDefinite is returned if M is not symmetric or not positive definite.
+func (m Matrix[N]) Cholesky() (Matrix[float64], error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, err
+	}
+	if !m.IsSquare() {
+		return Matrix[float64]{}, errNonSquare
+	}
+	dimension := len(m)
+	if dimension == 0 {
+		return Matrix[float64]{}, errZeroLength
+	}
+	if !m.IsSymmetric() {
+		return Matrix[float64]{}, errNotPositiveDefinite
+	}
+
+	a := m.float64Copy()
+	l := NewZeroMatrix[float64](dimension, dimension)
+	for j := 0; j < dimension; j++ {
+		diagonal := a[j][j]
+		for k := 0; k < j; k++ {
+			diagonal -= l[j][k] * l[j][k]
+		}
+		if diagonal <= 0.0 || math.IsNaN(diagonal) {
+			return Matrix[float64]{}, errNotPositiveDefinite
+		}
+		l[j][j] = math.Sqrt(diagonal)
+
+		for i := j + 1; i < dimension; i++ {
+			total := a[i][j]
+			for k := 0; k < j; k++ {
+				total -= l[i][k] * l[j][k]
+			}
+			l[i][j] = total / l[j][j]
+		}
+	}
+	return l, nil
+}
+
+// CholeskySolve returns the vector x satisfying M * x = b for a symmetric positive definite matrix M.
+// The system is solved from the Cholesky factor of M, taking roughly half the work of Solve.
+// errMultiplicationValidity is returned if the length of b does not match the rows of M
+// and errNotPositiveDefinite is returned if M is not symmetric positive definite.
+func (m Matrix[N]) CholeskySolve(b Vector[N]) (Vector[float64], error) {
+	rows, _ := m.Dimensions()
+	if len(b) != rows {
+		return Vector[float64]{}, errMultiplicationValidity
+	}
+	l, err := m.Cholesky()
+	if err != nil {
+		return Vector[float64]{}, err
+	}
+
+	// forward substitute L * y = b
+	y := make([]float64, rows)
+	for i := 0; i < rows; i++ {
+		total := float64(b[i])
+		for k := 0; k < i; k++ {
+			total -= l[i][k] * y[k]
+		}
+		y[i] = total / l[i][i]
+	}
+
+	// back substitute transpose(L) * x = y
+	x := make(Vector[float64], rows)
+	for i := rows - 1; i >= 0; i-- {
+		total := y[i]
+		for k := i + 1; k < rows; k++ {
+			total -= l[k][i] * x[k]
+		}
+		x[i] = total / l[i][i]
+	}
+	return x, nil
+}
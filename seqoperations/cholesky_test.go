@@ -0,0 +1,97 @@
+package seqoperations_test
+
+import (
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test Cholesky
+*/
+
+func TestCholeskyThreeByThree(t *testing.T) {
+	m := seqoperations.Matrix[int]{{4, 12, -16}, {12, 37, -43}, {-16, -43, 98}}
+	l, err := m.Cholesky()
+	assert.Nil(t, err)
+	e := seqoperations.Matrix[float64]{{2, 0, 0}, {6, 1, 0}, {-8, 5, 3}}
+	assert.True(t, e.WithinSigma(l, 1e-12))
+
+	llt, err := l.Multiply(l.SequentialTranspose())
+	assert.Nil(t, err)
+	assert.True(t, m.Float64Copy().WithinSigma(llt, 1e-12))
+}
+
+func TestCholeskyErrors(t *testing.T) {
+	_, err := seqoperations.Matrix[int]{}.Cholesky()
+	assert.Equal(t, e.ErrZeroLength, err)
+
+	_, err = seqoperations.Matrix[int]{{1, 2}}.Cholesky()
+	assert.Equal(t, e.ErrNonSquare, err)
+
+	_, err = seqoperations.Matrix[int]{{2, 1}, {0, 2}}.Cholesky()
+	assert.Equal(t, e.ErrNotPositiveDefinite, err)
+
+	_, err = seqoperations.Matrix[int]{{1, 2}, {2, 1}}.Cholesky()
+	assert.Equal(t, e.ErrNotPositiveDefinite, err)
+
+	_, err = seqoperations.Matrix[int]{{0, 0}, {0, 0}}.Cholesky()
+	assert.Equal(t, e.ErrNotPositiveDefinite, err)
+}
+
+func TestIsSymmetric(t *testing.T) {
+	assert.True(t, seqoperations.Matrix[int]{}.IsSymmetric())
+	assert.True(t, seqoperations.Matrix[int]{{1, 2}, {2, 3}}.IsSymmetric())
+	assert.False(t, seqoperations.Matrix[int]{{1, 2}, {3, 4}}.IsSymmetric())
+	assert.False(t, seqoperations.Matrix[int]{{1, 2}}.IsSymmetric())
+	assert.False(t, seqoperations.Matrix[int]{{1, 2, 3}, {2, 3}, {3}}.IsSymmetric())
+	assert.False(t, seqoperations.Matrix[uint]{{1, 2}, {3, 4}}.IsSymmetric())
+
+	// integers differing by one are not symmetric however large they are, even beyond 1 / eps
+	assert.False(t, seqoperations.Matrix[int64]{{1 << 60, 1 << 60}, {1<<60 + 1, 1}}.IsSymmetric())
+	assert.False(t, seqoperations.Matrix[uint64]{{1 << 62, 1<<62 + 1}, {1 << 62, 1}}.IsSymmetric())
+	assert.True(t, seqoperations.Matrix[int64]{{1 << 60, 1<<60 + 1}, {1<<60 + 1, 1}}.IsSymmetric())
+
+	// asymmetry at the level of rounding error is tolerated, relative to the largest element
+	assert.True(t, seqoperations.Matrix[float64]{{4, 0.1 + 0.2}, {0.3, 5}}.IsSymmetric())
+	assert.True(t, seqoperations.Matrix[float64]{{4e8, 1 + 1e-8}, {1, 5}}.IsSymmetric())
+	assert.False(t, seqoperations.Matrix[float64]{{4, 1 + 1e-8}, {1, 5}}.IsSymmetric())
+}
+
+func TestCholeskyAcceptsRoundingAsymmetry(t *testing.T) {
+	// a covariance matrix accumulated in a different order above and below the diagonal
+	m := seqoperations.Matrix[float64]{{2, 0.1 + 0.2, 0.7}, {0.3, 3, 0.1 * 3}, {0.7, 0.3, 4}}
+	l, err := m.Cholesky()
+	assert.Nil(t, err)
+	llt, err := l.Multiply(l.SequentialTranspose())
+	assert.Nil(t, err)
+	assert.True(t, m.WithinSigma(llt, 1e-12))
+
+	values, _, err := m.EigenSymmetric()
+	assert.Nil(t, err)
+	assert.Len(t, values, 3)
+}
+
+/*
+Test CholeskySolve
+*/
+
+func TestCholeskySolveMatchesSolve(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{4, 1, 2}, {1, 5, 3}, {2, 3, 6}}
+	b := seqoperations.Vector[float64]{1, -2, 4}
+	x, err := m.CholeskySolve(b)
+	assert.Nil(t, err)
+	expected, err := m.Solve(b)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64(expected), []float64(x), 1e-12)
+}
+
+func TestCholeskySolveErrors(t *testing.T) {
+	_, err := seqoperations.Matrix[int]{{4, 1}, {1, 5}}.CholeskySolve(seqoperations.Vector[int]{1})
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+
+	_, err = seqoperations.Matrix[int]{{1, 2}, {2, 1}}.CholeskySolve(seqoperations.Vector[int]{1, 1})
+	assert.Equal(t, e.ErrNotPositiveDefinite, err)
+}
@@ -66,3 +66,22 @@ func (m Matrix[N]) FillMatrix(x N) {
 		}
 	}
 }
+
+// AsRowMatrix returns a 1 x len(v) matrix holding a copy of v
+func (v Vector[N]) AsRowMatrix() Matrix[N] {
+	row := make([]N, len(v))
+	copy(row, v)
+	return Matrix[N]{row}
+}
+
+// AsColumnMatrix returns a len(v) x 1 matrix holding a copy of v.
+// The rows share a single allocation rather than each being allocated separately.
+func (v Vector[N]) AsColumnMatrix() Matrix[N] {
+	column := make([]N, len(v))
+	copy(column, v)
+	m := make(Matrix[N], len(v))
+	for i := range m {
+		m[i] = column[i : i+1 : i+1]
+	}
+	return m
+}
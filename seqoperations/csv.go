@@ -0,0 +1,32 @@
+package seqoperations
+
+import (
+	"io"
+
+	"github.com/DominicHinton/matrix/internal/textio"
+)
+
+// ReadCSV reads a matrix from delimited text in r, one row per record.
+// Fields are trimmed of surrounding spaces and parsed as N, so that a float read into an integer matrix or
+// a value outside the range of N is an error rather than being truncated. A record with a different number
+// of fields from the first wraps errRagged. Both are returned as an *errors.ParseError giving the line and
+// column of the field, while malformed quoting is reported by encoding/csv as a *csv.ParseError.
+// Empty input gives an empty matrix and errors.ErrInvalidDelimiter is returned if the delimiter cannot separate fields.
+func ReadCSV[N Number](r io.Reader, options ...CSVOption) (Matrix[N], error) {
+	m, err := textio.ReadCSV[N](r, options)
+	if err != nil {
+		return nil, err
+	}
+	return Matrix[N](m), nil
+}
+
+// WriteCSV writes m to w as delimited text, one record per row.
+// Integers are written in base 10 and floats in the shortest form that reads back as the same value.
+// errDifferentDimension is returned if column names are supplied that do not match the columns of m
+// and errors.ErrInvalidDelimiter if the delimiter cannot separate fields.
+func (m Matrix[N]) WriteCSV(w io.Writer, options ...CSVOption) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
+	return textio.WriteCSV(w, m, options)
+}
@@ -0,0 +1,142 @@
+package seqoperations_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test ReadCSV
+*/
+
+func TestReadCSV(t *testing.T) {
+	m, err := seqoperations.ReadCSV[int](strings.NewReader("1,2,3\n4, 5 ,-6\n"))
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, -6}}, m)
+
+	f, err := seqoperations.ReadCSV[float64](strings.NewReader("x;y\n1.5;-2e3\n\n0.1;\"7\"\n"),
+		seqoperations.WithDelimiter(';'), seqoperations.WithHeader())
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[float64]{{1.5, -2000}, {0.1, 7}}, f)
+
+	tabs, err := seqoperations.ReadCSV[uint8](strings.NewReader("255\t0\r\n1\t2\r\n"), seqoperations.WithDelimiter('\t'))
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[uint8]{{255, 0}, {1, 2}}, tabs)
+
+	empty, err := seqoperations.ReadCSV[int](strings.NewReader(""))
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[int]{}, empty)
+	empty, err = seqoperations.ReadCSV[int](strings.NewReader("a,b\n"), seqoperations.WithHeader())
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[int]{}, empty)
+
+	// an unusable delimiter is reported rather than ignored
+	for _, delimiter := range []rune{'"', '\r', '\n', 0, utf8.RuneError, -1} {
+		_, err = seqoperations.ReadCSV[int](strings.NewReader("1,2\n"), seqoperations.WithDelimiter(delimiter))
+		assert.Equal(t, e.ErrInvalidDelimiter, err)
+	}
+	// the last delimiter supplied is used
+	m, err = seqoperations.ReadCSV[int](strings.NewReader("1;2\n"), seqoperations.WithDelimiter('\n'), seqoperations.WithDelimiter(';'))
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[int]{{1, 2}}, m)
+}
+
+func TestReadCSVParseErrors(t *testing.T) {
+	var parseErr *e.ParseError
+
+	_, err := seqoperations.ReadCSV[int](strings.NewReader("1,2,3\n4,5.5,6\n"))
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 2, parseErr.Line)
+	assert.Equal(t, 2, parseErr.Column)
+	assert.True(t, errors.Is(err, strconv.ErrSyntax))
+	assert.Equal(t, `line 2, column 2: strconv.ParseInt: parsing "5.5": invalid syntax`, err.Error())
+
+	_, err = seqoperations.ReadCSV[int8](strings.NewReader("name\n\n1\n128\n"), seqoperations.WithHeader())
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 4, parseErr.Line)
+	assert.Equal(t, 1, parseErr.Column)
+	assert.True(t, errors.Is(err, strconv.ErrRange))
+
+	_, err = seqoperations.ReadCSV[uint](strings.NewReader("1,-1\n"))
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 2, parseErr.Column)
+
+	_, err = seqoperations.ReadCSV[float32](strings.NewReader("1,2\n3,\n"))
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 2, parseErr.Line)
+	assert.Equal(t, 2, parseErr.Column)
+
+	_, err = seqoperations.ReadCSV[int](strings.NewReader("1,2\n3,4,5\n"))
+	assert.True(t, errors.Is(err, e.ErrRagged))
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 2, parseErr.Line)
+	assert.Equal(t, 3, parseErr.Column)
+	_, err = seqoperations.ReadCSV[int](strings.NewReader("1,2\n3,4\n5\n"))
+	assert.True(t, errors.Is(err, e.ErrRagged))
+	assert.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, 3, parseErr.Line)
+	assert.Equal(t, 2, parseErr.Column)
+
+	var csvErr *csv.ParseError
+	_, err = seqoperations.ReadCSV[int](strings.NewReader("1,\"2\n"))
+	assert.True(t, errors.As(err, &csvErr))
+}
+
+/*
+Test WriteCSV
+*/
+
+func TestWriteCSV(t *testing.T) {
+	var b bytes.Buffer
+	err := seqoperations.Matrix[int]{{1, -2}, {30, 4}}.WriteCSV(&b)
+	assert.Nil(t, err)
+	assert.Equal(t, "1,-2\n30,4\n", b.String())
+
+	b.Reset()
+	err = seqoperations.Matrix[float32]{{0.1, 2.5e10}}.WriteCSV(&b, seqoperations.WithDelimiter(';'), seqoperations.WithColumnNames("a", "b;c"))
+	assert.Nil(t, err)
+	assert.Equal(t, "a;\"b;c\"\n0.1;2.5e+10\n", b.String())
+
+	err = seqoperations.Matrix[int]{{1, 2}}.WriteCSV(&b, seqoperations.WithColumnNames("a"))
+	assert.Equal(t, e.ErrDifferentDimension, err)
+	err = seqoperations.Matrix[int]{{1, 2}, {3}}.WriteCSV(&b)
+	assert.Equal(t, e.ErrRagged, err)
+
+	b.Reset()
+	err = seqoperations.Matrix[int]{{1, 2}}.WriteCSV(&b, seqoperations.WithDelimiter('"'))
+	assert.Equal(t, e.ErrInvalidDelimiter, err)
+	assert.Equal(t, "", b.String())
+}
+
+func TestCSVRoundTrip(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{math.Pi, -1e-300, 0}, {math.MaxFloat64, 1.0 / 3.0, math.Inf(-1)}}
+	var b bytes.Buffer
+	assert.Nil(t, m.WriteCSV(&b, seqoperations.WithColumnNames("x", "y", "z"), seqoperations.WithDelimiter('|')))
+	read, err := seqoperations.ReadCSV[float64](&b, seqoperations.WithHeader(), seqoperations.WithDelimiter('|'))
+	assert.Nil(t, err)
+	assert.Equal(t, m, read)
+
+	u := seqoperations.Matrix[uint64]{{math.MaxUint64, 0}}
+	b.Reset()
+	assert.Nil(t, u.WriteCSV(&b))
+	readUnsigned, err := seqoperations.ReadCSV[uint64](&b)
+	assert.Nil(t, err)
+	assert.Equal(t, u, readUnsigned)
+
+	i := seqoperations.Matrix[int16]{{math.MinInt16, math.MaxInt16}}
+	b.Reset()
+	assert.Nil(t, i.WriteCSV(&b))
+	readSigned, err := seqoperations.ReadCSV[int16](&b)
+	assert.Nil(t, err)
+	assert.Equal(t, i, readSigned)
+}
@@ -0,0 +1,148 @@
+package seqoperations
+
+// Dense is a matrix held in a single backing slice in row major order.
+// Element (i, j) is stored at data[i*stride+j], so a view onto part of a larger Dense shares its
+// backing slice and keeps its stride. Rows are contiguous in memory and a Dense cannot be ragged.
+type Dense[N Number] struct {
+	rows, columns, stride int
+	data                  []N
+}
+
+// NewDense returns an i x j Dense with all elements zero.
+// Either dimension may be zero, in which case the shape is kept although there are no elements.
+func NewDense[N Number](i, j int) Dense[N] {
+	if i < 0 || j < 0 {
+		return Dense[N]{}
+	}
+	return Dense[N]{rows: i, columns: j, stride: j, data: make([]N, i*j)}
+}
+
+// NewDenseFromSlice returns an i x j Dense backed by data, which is used without copying.
+// errDifferentDimension is returned if data does not hold exactly i * j elements.
+func NewDenseFromSlice[N Number](i, j int, data []N) (Dense[N], error) {
+	if i < 0 || j < 0 || len(data) != i*j {
+		return Dense[N]{}, errDifferentDimension
+	}
+	return Dense[N]{rows: i, columns: j, stride: j, data: data}, nil
+}
+
+// Dense returns a copy of the matrix in contiguous storage.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) Dense() (Dense[N], error) {
+	if err := m.Validate(); err != nil {
+		return Dense[N]{}, err
+	}
+	rows, columns := m.Dimensions()
+	d := NewDense[N](rows, columns)
+	for i := 0; i < d.rows; i++ {
+		copy(d.data[i*d.stride:i*d.stride+columns], m[i])
+	}
+	return d, nil
+}
+
+// Matrix returns a copy of d as a Matrix
+func (d Dense[N]) Matrix() Matrix[N] {
+	m := NewZeroMatrix[N](d.rows, d.columns)
+	for i := 0; i < d.rows; i++ {
+		copy(m[i], d.RawRow(i))
+	}
+	return m
+}
+
+// Dimensions returns the number of rows and columns of d
+func (d Dense[N]) Dimensions() (int, int) {
+	return d.rows, d.columns
+}
+
+// Stride returns the distance in the backing slice between the starts of consecutive rows
+func (d Dense[N]) Stride() int {
+	return d.stride
+}
+
+// At returns element (i, j) of d. At panics if i or j is out of range.
+func (d Dense[N]) At(i, j int) N {
+	d.checkIndex(i, j)
+	return d.data[i*d.stride+j]
+}
+
+// Set sets element (i, j) of d to value. Set panics if i or j is out of range.
+func (d Dense[N]) Set(i, j int, value N) {
+	d.checkIndex(i, j)
+	d.data[i*d.stride+j] = value
+}
+
+// RawRow returns row i of d as a vector sharing the backing slice of d, so writes to the vector
+// change d. The capacity of the vector is limited to the row so appending to it copies.
+// RawRow panics if i is out of range.
+func (d Dense[N]) RawRow(i int) Vector[N] {
+	if i < 0 || i >= d.rows {
+		panic(errRowColSuppliedOutBounds)
+	}
+	start := i * d.stride
+	return Vector[N](d.data[start : start+d.columns : start+d.columns])
+}
+
+// RowView returns row i of d as a 1 x j Dense sharing the backing slice of d
+// if the row exists, otherwise false
+func (d Dense[N]) RowView(i int) (Dense[N], bool) {
+	view, err := d.View(i, 0, i+1, d.columns)
+	return view, err == nil
+}
+
+// ColumnView returns column j of d as an i x 1 Dense sharing the backing slice of d
+// if the column exists, otherwise false
+func (d Dense[N]) ColumnView(j int) (Dense[N], bool) {
+	view, err := d.View(0, j, d.rows, j+1)
+	return view, err == nil
+}
+
+// View returns the rows rowMin to rowMax-1 and columns colMin to colMax-1 of d as a Dense sharing
+// the backing slice of d, so writes through either are visible in both.
+// errRowColSuppliedOutBounds is returned if the range is empty or outside d.
+func (d Dense[N]) View(rowMin, colMin, rowMax, colMax int) (Dense[N], error) {
+	if rowMin < 0 || rowMin >= rowMax || rowMax > d.rows || colMin < 0 || colMin >= colMax || colMax > d.columns {
+		return Dense[N]{}, errRowColSuppliedOutBounds
+	}
+	start := rowMin*d.stride + colMin
+	end := (rowMax-1)*d.stride + colMax
+	return Dense[N]{
+		rows:    rowMax - rowMin,
+		columns: colMax - colMin,
+		stride:  d.stride,
+		data:    d.data[start:end:end],
+	}, nil
+}
+
+// Copy returns a copy of d with its own compact backing slice
+func (d Dense[N]) Copy() Dense[N] {
+	out := NewDense[N](d.rows, d.columns)
+	for i := 0; i < d.rows; i++ {
+		copy(out.data[i*out.stride:(i+1)*out.stride], d.RawRow(i))
+	}
+	return out
+}
+
+// checkIndex panics if (i, j) is not an element of d
+func (d Dense[N]) checkIndex(i, j int) {
+	if i < 0 || i >= d.rows || j < 0 || j >= d.columns {
+		panic(errRowColSuppliedOutBounds)
+	}
+}
+
+// Multiply returns P = D * E if multiplication is valid.
+// Each row of P accumulates scaled rows of E, so every loop reads contiguous memory.
+func (d Dense[N]) Multiply(e Dense[N]) (Dense[N], error) {
+	if d.columns != e.rows {
+		return Dense[N]{}, errMultiplicationValidity
+	}
+	out := NewDense[N](d.rows, e.columns)
+	for i := 0; i < out.rows; i++ {
+		outRow := out.RawRow(i)
+		for k, scale := range d.RawRow(i) {
+			for j, element := range e.RawRow(k) {
+				outRow[j] += scale * element
+			}
+		}
+	}
+	return out, nil
+}
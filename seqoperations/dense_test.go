@@ -0,0 +1,159 @@
+package seqoperations_test
+
+import (
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test Dense construction and conversion
+*/
+
+func TestDenseFromMatrixRoundTrip(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}}
+	d, err := m.Dense()
+	assert.Nil(t, err)
+	rows, columns := d.Dimensions()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 3, columns)
+	assert.Equal(t, 3, d.Stride())
+	assert.Equal(t, 6, d.At(1, 2))
+	assert.Equal(t, m, d.Matrix())
+
+	// the Dense is a copy so changes do not reach the matrix
+	d.Set(0, 0, 9)
+	assert.Equal(t, 1, m[0][0])
+
+	for _, ragged := range []seqoperations.Matrix[int]{{{1, 2}, {3}}, {{1}, {2, 3}}} {
+		_, err = ragged.Dense()
+		assert.Equal(t, e.ErrRagged, err)
+	}
+}
+
+func TestNewDenseFromSlice(t *testing.T) {
+	data := []float64{1, 2, 3, 4, 5, 6}
+	d, err := seqoperations.NewDenseFromSlice(3, 2, data)
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[float64]{{1, 2}, {3, 4}, {5, 6}}, d.Matrix())
+
+	// the slice is used without copying
+	d.Set(2, 1, 60)
+	assert.Equal(t, 60.0, data[5])
+
+	_, err = seqoperations.NewDenseFromSlice(2, 2, data)
+	assert.Equal(t, e.ErrDifferentDimension, err)
+
+	empty, err := seqoperations.NewDenseFromSlice[int](0, 4, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[int]{}, empty.Matrix())
+}
+
+func TestDenseZeroWidthShape(t *testing.T) {
+	d := seqoperations.NewDense[int](2, 0)
+	rows, columns := d.Dimensions()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 0, columns)
+	assert.Equal(t, seqoperations.Matrix[int]{{}, {}}, d.Matrix())
+	assert.Equal(t, seqoperations.Vector[int]{}, d.RawRow(1))
+	assert.Equal(t, seqoperations.Matrix[int]{{}, {}}, d.Copy().Matrix())
+
+	fromMatrix, err := seqoperations.Matrix[int]{{}, {}}.Dense()
+	assert.Nil(t, err)
+	rows, columns = fromMatrix.Dimensions()
+	assert.Equal(t, 2, rows)
+	assert.Equal(t, 0, columns)
+
+	// a 2 x 0 by 0 x 3 product is a 2 x 3 matrix of zeros
+	product, err := d.Multiply(seqoperations.NewDense[int](0, 3))
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.NewZeroMatrix[int](2, 3), product.Matrix())
+}
+
+func TestDenseIndexOutOfRangePanics(t *testing.T) {
+	d := seqoperations.NewDense[int](2, 2)
+	assert.Panics(t, func() { d.At(2, 0) })
+	assert.Panics(t, func() { d.At(0, -1) })
+	assert.Panics(t, func() { d.Set(0, 2, 1) })
+}
+
+/*
+Test Dense views share storage
+*/
+
+func TestDenseViews(t *testing.T) {
+	d, err := seqoperations.Matrix[int]{
+		{1, 2, 3, 4},
+		{5, 6, 7, 8},
+		{9, 10, 11, 12},
+	}.Dense()
+	assert.Nil(t, err)
+
+	view, err := d.View(1, 1, 3, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[int]{{6, 7}, {10, 11}}, view.Matrix())
+	assert.Equal(t, 4, view.Stride())
+	view.Set(1, 0, 100)
+	assert.Equal(t, 100, d.At(2, 1))
+
+	row, ok := d.RowView(2)
+	assert.True(t, ok)
+	assert.Equal(t, seqoperations.Matrix[int]{{9, 100, 11, 12}}, row.Matrix())
+
+	column, ok := d.ColumnView(3)
+	assert.True(t, ok)
+	assert.Equal(t, seqoperations.Matrix[int]{{4}, {8}, {12}}, column.Matrix())
+	column.Set(0, 0, 40)
+	assert.Equal(t, 40, d.At(0, 3))
+
+	raw := d.RawRow(1)
+	raw[0] = 50
+	assert.Equal(t, 50, d.At(1, 0))
+	// appending to a row must not overwrite the next row
+	_ = append(raw, -1)
+	assert.Equal(t, 9, d.At(2, 0))
+
+	compact := view.Copy()
+	assert.Equal(t, 2, compact.Stride())
+	compact.Set(0, 0, -6)
+	assert.Equal(t, 6, d.At(1, 1))
+
+	_, ok = d.RowView(3)
+	assert.False(t, ok)
+	_, ok = d.ColumnView(-1)
+	assert.False(t, ok)
+	_, err = d.View(0, 0, 4, 2)
+	assert.Equal(t, e.ErrRowColSuppliedOutBounds, err)
+	_, err = d.View(1, 1, 1, 2)
+	assert.Equal(t, e.ErrRowColSuppliedOutBounds, err)
+}
+
+/*
+Test Dense Multiply agrees with Matrix Multiply
+*/
+
+func TestDenseMultiply(t *testing.T) {
+	a := seqoperations.NewMatrixFromSlice(7, 5, []int{3, -1, 4, 1, -5, 9, 2, -6})
+	b := seqoperations.NewMatrixFromSlice(5, 6, []int{2, 7, -1, 8, 2, 8, -1})
+	expected, err := a.Multiply(b)
+	assert.Nil(t, err)
+
+	aDense, err := a.Dense()
+	assert.Nil(t, err)
+	bDense, err := b.Dense()
+	assert.Nil(t, err)
+	product, err := aDense.Multiply(bDense)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, product.Matrix())
+
+	// views of a larger matrix multiply through their stride
+	view, _ := aDense.View(2, 0, 7, 5)
+	fromView, err := view.Multiply(bDense)
+	assert.Nil(t, err)
+	assert.Equal(t, expected[2:], fromView.Matrix())
+
+	_, err = aDense.Multiply(aDense)
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+}
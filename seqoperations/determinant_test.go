@@ -0,0 +1,81 @@
+package seqoperations_test
+
+import (
+	"math"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test Determinant
+*/
+
+func TestDeterminantSmallMatrices(t *testing.T) {
+	det, err := seqoperations.Matrix[int]{{5}}.Determinant()
+	assert.Nil(t, err)
+	assert.Equal(t, 5.0, det)
+
+	det, err = seqoperations.Matrix[int]{{1, 2}, {3, 4}}.Determinant()
+	assert.Nil(t, err)
+	assert.InDelta(t, -2.0, det, 1e-12)
+
+	det, err = seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}.Determinant()
+	assert.Nil(t, err)
+	assert.InDelta(t, -3.0, det, 1e-12)
+}
+
+func TestDeterminantSignOfRowExchange(t *testing.T) {
+	det, err := seqoperations.Matrix[int]{{0, 1}, {1, 0}}.Determinant()
+	assert.Nil(t, err)
+	assert.Equal(t, -1.0, det)
+
+	det, err = seqoperations.Matrix[int]{{0, 0, 1}, {0, 1, 0}, {1, 0, 0}}.Determinant()
+	assert.Nil(t, err)
+	assert.Equal(t, -1.0, det)
+}
+
+func TestDeterminantSingular(t *testing.T) {
+	det, err := seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}.Determinant()
+	assert.Nil(t, err)
+	assert.InDelta(t, 0.0, det, 1e-12)
+
+	det, err = seqoperations.Matrix[int]{{0, 1}, {0, 2}}.Determinant()
+	assert.Nil(t, err)
+	assert.Equal(t, 0.0, det)
+}
+
+func TestDeterminantLargeDiagonal(t *testing.T) {
+	// far beyond what cofactor expansion could return in reasonable time
+	dimension := 60
+	m := seqoperations.NewIdentityMatrix[float64](dimension)
+	for i := 0; i < dimension; i++ {
+		m[i][i] = 1.1
+		if i > 0 {
+			m[i][i-1] = 0.5
+		}
+	}
+	det, err := m.Determinant()
+	assert.Nil(t, err)
+	assert.InDelta(t, math.Pow(1.1, float64(dimension)), det, 1e-9)
+}
+
+func TestDeterminantDoesNotModifyInput(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{0, 2}, {3, 4}}
+	_, err := m.DeterminantAssumeFloat64Input()
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[float64]{{0, 2}, {3, 4}}, m)
+}
+
+func TestDeterminantErrors(t *testing.T) {
+	_, err := seqoperations.Matrix[int]{}.Determinant()
+	assert.Equal(t, e.ErrZeroLength, err)
+
+	_, err = seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}}.Determinant()
+	assert.Equal(t, e.ErrNonSquare, err)
+
+	_, err = seqoperations.Matrix[int]{{1}}.DeterminantAssumeFloat64Input()
+	assert.Equal(t, e.ErrNotFloat64, err)
+}
@@ -0,0 +1,118 @@
+package seqoperations
+
+import "math"
+
+// RowEchelon returns a float64 copy of the matrix reduced to row echelon form by Gaussian elimination
+// with partial pivoting, along with the column index of the pivot in each non zero row.
+// Elements small enough relative to the largest element of the matrix to be rounding error are treated as zero.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) RowEchelon() (Matrix[float64], []int, error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, nil, err
+	}
+	matrix := m.float64Copy()
+	pivots := rowReduce(matrix, false)
+	return matrix, pivots, nil
+}
+
+// ReducedRowEchelon returns a float64 copy of the matrix reduced to reduced row echelon form,
+// where every pivot is one and is the only non zero element in its column, along with the pivot columns.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) ReducedRowEchelon() (Matrix[float64], []int, error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, nil, err
+	}
+	matrix := m.float64Copy()
+	pivots := rowReduce(matrix, true)
+	return matrix, pivots, nil
+}
+
+// EchelonRank returns the rank of the matrix as the number of pivots in its row echelon form.
+// It is kept alongside Rank, which counts the singular values above a tolerance, because the two trade
+// cost against robustness: EchelonRank needs a single elimination where Rank needs an SVD, but its pivot
+// test is relative to the largest element so it can overstate the rank of ill conditioned matrices.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) EchelonRank() (int, error) {
+	_, pivots, err := m.RowEchelon()
+	if err != nil {
+		return 0, err
+	}
+	return len(pivots), nil
+}
+
+// NullSpace returns a matrix whose columns form a basis of the null space of the matrix,
+// i.e. vectors x with M * x = 0, derived from its reduced row echelon form.
+// For a matrix with j columns and rank r the result is j x (j - r).
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) NullSpace() (Matrix[float64], error) {
+	reduced, pivots, err := m.ReducedRowEchelon()
+	if err != nil {
+		return Matrix[float64]{}, err
+	}
+	_, columns := m.Dimensions()
+
+	isPivot := make([]bool, columns)
+	for _, column := range pivots {
+		isPivot[column] = true
+	}
+
+	basis := NewZeroMatrix[float64](columns, columns-len(pivots))
+	free := 0
+	for column := 0; column < columns; column++ {
+		if isPivot[column] {
+			continue
+		}
+		// set this free variable to one and solve for the pivot variables
+		basis[column][free] = 1.0
+		for row, pivotColumn := range pivots {
+			basis[pivotColumn][free] = -reduced[row][column]
+		}
+		free++
+	}
+	return basis, nil
+}
+
+// rowReduce carries out Gaussian elimination with partial pivoting on a in place and returns the pivot columns.
+// If reduced is true every pivot is scaled to one and eliminated from the rows above as well as below.
+func rowReduce(a Matrix[float64], reduced bool) []int {
+	rows, columns := a.Dimensions()
+	tolerance := singularTolerance(a)
+	pivots := []int{}
+
+	row := 0
+	for column := 0; column < columns && row < rows; column++ {
+		pivot := a.FindMaxPivot(row, column)
+		if pivot == -1 || math.Abs(a[pivot][column]) <= tolerance {
+			// nothing to pivot on, clear any rounding error left in the column
+			for i := row; i < rows; i++ {
+				a[i][column] = 0.0
+			}
+			continue
+		}
+		// rows are known to be in bounds so errors can be discarded
+		_ = a.SwapRows(row, pivot)
+		if reduced {
+			_ = a.RowScalarMultiply(row, 1.0/a[row][column])
+			a[row][column] = 1.0
+		}
+
+		start := row + 1
+		if reduced {
+			start = 0
+		}
+		for i := start; i < rows; i++ {
+			if i == row || a[i][column] == 0.0 {
+				continue
+			}
+			factor := a[i][column] / a[row][column]
+			for j := column; j < columns; j++ {
+				a[i][j] -= factor * a[row][j]
+			}
+			a[i][column] = 0.0
+		}
+
+		pivots = append(pivots, column)
+		row++
+	}
+	return pivots
+}
@@ -0,0 +1,89 @@
+package seqoperations_test
+
+import (
+	"testing"
+
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test RowEchelon and ReducedRowEchelon
+*/
+
+func TestRowEchelonIsUpperStaircase(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2, 1, 1}, {2, 4, 0, 6}, {1, 2, 2, -1}}
+	echelon, pivots, err := m.RowEchelon()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 2}, pivots)
+	for row, column := range pivots {
+		assert.NotEqual(t, 0.0, echelon[row][column])
+		for i := row + 1; i < len(echelon); i++ {
+			assert.Equal(t, 0.0, echelon[i][column])
+		}
+	}
+	assert.Equal(t, []float64{0, 0, 0, 0}, echelon[2])
+}
+
+func TestReducedRowEchelon(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2, 1, 1}, {2, 4, 0, 6}, {1, 2, 2, -1}}
+	reduced, pivots, err := m.ReducedRowEchelon()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 2}, pivots)
+	e := seqoperations.Matrix[float64]{{1, 2, 0, 3}, {0, 0, 1, -2}, {0, 0, 0, 0}}
+	assert.True(t, e.WithinSigma(reduced, 1e-12))
+}
+
+func TestReducedRowEchelonOfInvertibleIsIdentity(t *testing.T) {
+	m := seqoperations.Matrix[int]{{0, 2, 1}, {4, 1, -2}, {2, 3, 5}}
+	reduced, pivots, err := m.ReducedRowEchelon()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{0, 1, 2}, pivots)
+	assert.True(t, seqoperations.NewIdentityMatrix[float64](3).WithinSigma(reduced, 1e-12))
+}
+
+func TestRowEchelonEmpty(t *testing.T) {
+	echelon, pivots, err := seqoperations.Matrix[int]{}.RowEchelon()
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[float64]{}, echelon)
+	assert.Equal(t, []int{}, pivots)
+}
+
+/*
+Test EchelonRank and NullSpace
+*/
+
+func TestEchelonRank(t *testing.T) {
+	for expected, m := range map[int]seqoperations.Matrix[float64]{
+		2: {{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+		3: seqoperations.NewIdentityMatrix[float64](3),
+		0: seqoperations.NewZeroMatrix[float64](2, 3),
+		1: {{0.1, 0.2}, {0.3, 0.6}},
+	} {
+		rank, err := m.EchelonRank()
+		assert.Nil(t, err)
+		assert.Equal(t, expected, rank)
+	}
+}
+
+func TestNullSpace(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2, 1, 1}, {2, 4, 0, 6}, {1, 2, 2, -1}}
+	basis, err := m.NullSpace()
+	assert.Nil(t, err)
+	rows, columns := basis.Dimensions()
+	assert.Equal(t, 4, rows)
+	assert.Equal(t, 2, columns)
+
+	product, err := m.Float64Copy().Multiply(basis)
+	assert.Nil(t, err)
+	assert.True(t, seqoperations.NewZeroMatrix[float64](3, 2).WithinSigma(product, 1e-12))
+	rank, err := basis.EchelonRank()
+	assert.Nil(t, err)
+	assert.Equal(t, 2, rank)
+}
+
+func TestNullSpaceFullRank(t *testing.T) {
+	basis, err := seqoperations.NewIdentityMatrix[int](3).NullSpace()
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[float64]{{}, {}, {}}, basis)
+}
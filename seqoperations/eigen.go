@@ -0,0 +1,264 @@
+package seqoperations
+
+import (
+	"math"
+	"sort"
+)
+
+// Hessenberg returns the upper Hessenberg form H of a square matrix M, which is zero below the
+// first subdiagonal, along with the orthogonal matrix Q satisfying M = Q * H * transpose(Q).
+// The reduction is carried out with Householder reflections so H has the same eigenvalues as M.
+func (m Matrix[N]) Hessenberg() (Matrix[float64], Matrix[float64], error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, Matrix[float64]{}, err
+	}
+	if !m.IsSquare() {
+		return Matrix[float64]{}, Matrix[float64]{}, errNonSquare
+	}
+	dimension := len(m)
+	if dimension == 0 {
+		return Matrix[float64]{}, Matrix[float64]{}, errZeroLength
+	}
+
+	h := m.float64Copy()
+	q := NewIdentityMatrix[float64](dimension)
+	for k := 0; k < dimension-2; k++ {
+		v, ok := householderVector(h, k+1, k)
+		if !ok {
+			continue
+		}
+		applyHouseholderLeft(h, v, k+1, k)
+		applyHouseholderRight(h, v, k+1)
+		applyHouseholderRight(q, v, k+1)
+	}
+
+	// entries below the subdiagonal are zero up to rounding so are set exactly
+	for i := 2; i < dimension; i++ {
+		for j := 0; j < i-1; j++ {
+			h[i][j] = 0.0
+		}
+	}
+	return h, q, nil
+}
+
+// Eigen returns the eigenvalues of a square matrix, which need not be symmetric, as separate
+// vectors of real and imaginary parts. Complex eigenvalues appear as adjacent conjugate pairs.
+// Eigenvalues are ordered by ascending real part and then ascending imaginary part.
+// The matrix is reduced to Hessenberg form and then iterated with the Francis double shifted QR algorithm.
+// WithMaxIterations limits the iterations spent finding each eigenvalue and WithTolerance sets the
+// relative size below which a subdiagonal element is treated as zero.
+// errNoConvergence is returned if the iteration limit is reached.
+func (m Matrix[N]) Eigen(options ...IterationOption) (Vector[float64], Vector[float64], error) {
+	h, _, err := m.Hessenberg()
+	if err != nil {
+		return Vector[float64]{}, Vector[float64]{}, err
+	}
+	settings := newIterationSettings(options)
+
+	realParts, imaginaryParts, err := hessenbergEigenvalues(h, settings)
+	if err != nil {
+		return Vector[float64]{}, Vector[float64]{}, err
+	}
+
+	dimension := len(realParts)
+	order := make([]int, dimension)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		if realParts[order[i]] != realParts[order[j]] {
+			return realParts[order[i]] < realParts[order[j]]
+		}
+		return imaginaryParts[order[i]] < imaginaryParts[order[j]]
+	})
+
+	realOut, imaginaryOut := make(Vector[float64], dimension), make(Vector[float64], dimension)
+	for j, k := range order {
+		realOut[j], imaginaryOut[j] = realParts[k], imaginaryParts[k]
+	}
+	return realOut, imaginaryOut, nil
+}
+
+// SpectralRadius returns the largest modulus of the eigenvalues of a square matrix.
+// A discrete time transition matrix is stable when its spectral radius is less than one.
+func (m Matrix[N]) SpectralRadius(options ...IterationOption) (float64, error) {
+	realParts, imaginaryParts, err := m.Eigen(options...)
+	if err != nil {
+		return 0.0, err
+	}
+	radius := 0.0
+	for i := range realParts {
+		radius = math.Max(radius, math.Hypot(realParts[i], imaginaryParts[i]))
+	}
+	return radius, nil
+}
+
+// hessenbergEigenvalues finds every eigenvalue of the upper Hessenberg matrix a in place using
+// the Francis double shifted QR algorithm, deflating one or two eigenvalues at a time from the bottom
+func hessenbergEigenvalues(a Matrix[float64], settings iterationSettings) ([]float64, []float64, error) {
+	dimension := len(a)
+	realParts, imaginaryParts := make([]float64, dimension), make([]float64, dimension)
+	tolerance := math.Max(settings.tolerance, machineEpsilon)
+
+	norm := 0.0
+	for i := 0; i < dimension; i++ {
+		for j := i - 1; j < dimension; j++ {
+			if j >= 0 {
+				norm += math.Abs(a[i][j])
+			}
+		}
+	}
+
+	// shift accumulated by exceptional shifts
+	shift := 0.0
+	last := dimension - 1
+	for last >= 0 {
+		iterations := 0
+		for {
+			// look for a single small subdiagonal element
+			l := last
+			for ; l >= 1; l-- {
+				s := math.Abs(a[l-1][l-1]) + math.Abs(a[l][l])
+				if s == 0.0 {
+					s = norm
+				}
+				if math.Abs(a[l][l-1]) <= tolerance*s {
+					a[l][l-1] = 0.0
+					break
+				}
+			}
+
+			x := a[last][last]
+			if l == last {
+				// one real eigenvalue has been found
+				realParts[last], imaginaryParts[last] = x+shift, 0.0
+				last--
+				break
+			}
+
+			y := a[last-1][last-1]
+			w := a[last][last-1] * a[last-1][last]
+			if l == last-1 {
+				// a pair of eigenvalues has been found from the trailing 2x2 block
+				p := 0.5 * (y - x)
+				q := p*p + w
+				z := math.Sqrt(math.Abs(q))
+				x += shift
+				if q >= 0.0 {
+					z = p + math.Copysign(z, p)
+					realParts[last-1], realParts[last] = x+z, x+z
+					if z != 0.0 {
+						realParts[last] = x - w/z
+					}
+					imaginaryParts[last-1], imaginaryParts[last] = 0.0, 0.0
+				} else {
+					realParts[last-1], realParts[last] = x+p, x+p
+					imaginaryParts[last-1], imaginaryParts[last] = -z, z
+				}
+				last -= 2
+				break
+			}
+
+			if iterations == settings.maxIterations {
+				return nil, nil, errNoConvergence
+			}
+			if iterations == 10 || iterations == 20 {
+				// exceptional shift to break cycles
+				shift += x
+				for i := 0; i <= last; i++ {
+					a[i][i] -= x
+				}
+				s := math.Abs(a[last][last-1]) + math.Abs(a[last-1][last-2])
+				x = 0.75 * s
+				y = x
+				w = -0.4375 * s * s
+			}
+			iterations++
+
+			// form the double shift and look for two consecutive small subdiagonal elements
+			var p, q, r, z float64
+			start := last - 2
+			for ; start >= l; start-- {
+				z = a[start][start]
+				r = x - z
+				s := y - z
+				p = (r*s-w)/a[start+1][start] + a[start][start+1]
+				q = a[start+1][start+1] - z - r - s
+				r = a[start+2][start+1]
+				s = math.Abs(p) + math.Abs(q) + math.Abs(r)
+				p, q, r = p/s, q/s, r/s
+				if start == l {
+					break
+				}
+				u := math.Abs(a[start][start-1]) * (math.Abs(q) + math.Abs(r))
+				v := math.Abs(p) * (math.Abs(a[start-1][start-1]) + math.Abs(z) + math.Abs(a[start+1][start+1]))
+				if u <= machineEpsilon*v {
+					break
+				}
+			}
+			for i := start + 2; i <= last; i++ {
+				a[i][i-2] = 0.0
+				if i != start+2 {
+					a[i][i-3] = 0.0
+				}
+			}
+
+			// double QR step on rows l to last and columns start to last
+			for k := start; k <= last-1; k++ {
+				if k != start {
+					p = a[k][k-1]
+					q = a[k+1][k-1]
+					r = 0.0
+					if k != last-1 {
+						r = a[k+2][k-1]
+					}
+					x = math.Abs(p) + math.Abs(q) + math.Abs(r)
+					if x != 0.0 {
+						p, q, r = p/x, q/x, r/x
+					}
+				}
+				s := math.Copysign(math.Sqrt(p*p+q*q+r*r), p)
+				if s == 0.0 {
+					continue
+				}
+				if k == start {
+					if l != start {
+						a[k][k-1] = -a[k][k-1]
+					}
+				} else {
+					a[k][k-1] = -s * x
+				}
+				p += s
+				x, y, z = p/s, q/s, r/s
+				q, r = q/p, r/p
+
+				// row modification
+				for j := k; j <= last; j++ {
+					p = a[k][j] + q*a[k+1][j]
+					if k != last-1 {
+						p += r * a[k+2][j]
+						a[k+2][j] -= p * z
+					}
+					a[k+1][j] -= p * y
+					a[k][j] -= p * x
+				}
+
+				// column modification
+				rowLimit := last
+				if k+3 < last {
+					rowLimit = k + 3
+				}
+				for i := l; i <= rowLimit; i++ {
+					p = x*a[i][k] + y*a[i][k+1]
+					if k != last-1 {
+						p += z * a[i][k+2]
+						a[i][k+2] -= p * r
+					}
+					a[i][k+1] -= p * q
+					a[i][k] -= p
+				}
+			}
+		}
+	}
+	return realParts, imaginaryParts, nil
+}
@@ -0,0 +1,124 @@
+package seqoperations
+
+import (
+	"math"
+	"sort"
+)
+
+// EigenSymmetric returns the eigenvalues of a symmetric matrix in ascending order together with
+// a matrix whose columns are the corresponding unit eigenvectors, found with the cyclic Jacobi method.
+// Each iteration is one sweep of rotations over every off diagonal element; iteration stops once the
+// off diagonal norm falls below the tolerance relative to the norm of the matrix.
+// The stopping criteria may be adjusted with WithTolerance and WithMaxIterations.
+// errNotSymmetric is returned for non-symmetric input and errNoConvergence if the iteration limit is reached.
+func (m Matrix[N]) EigenSymmetric(options ...IterationOption) (Vector[float64], Matrix[float64], error) {
+	if err := m.Validate(); err != nil {
+		return Vector[float64]{}, Matrix[float64]{}, err
+	}
+	if !m.IsSquare() {
+		return Vector[float64]{}, Matrix[float64]{}, errNonSquare
+	}
+	dimension := len(m)
+	if dimension == 0 {
+		return Vector[float64]{}, Matrix[float64]{}, errZeroLength
+	}
+	if !m.IsSymmetric() {
+		return Vector[float64]{}, Matrix[float64]{}, errNotSymmetric
+	}
+	settings := newIterationSettings(options)
+
+	a := m.float64Copy()
+	v := NewIdentityMatrix[float64](dimension)
+	threshold := settings.tolerance * frobenius(a)
+
+	converged := false
+	for sweep := 0; sweep < settings.maxIterations; sweep++ {
+		if offDiagonalNorm(a) <= threshold {
+			converged = true
+			break
+		}
+		for p := 0; p < dimension-1; p++ {
+			for q := p + 1; q < dimension; q++ {
+				jacobiRotate(a, v, p, q)
+			}
+		}
+	}
+	if !converged && offDiagonalNorm(a) > threshold {
+		return Vector[float64]{}, Matrix[float64]{}, errNoConvergence
+	}
+
+	// order eigenvalues ascending, moving eigenvector columns with them
+	order := make([]int, dimension)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return a[order[i]][order[i]] < a[order[j]][order[j]] })
+
+	values := make(Vector[float64], dimension)
+	vectors := NewZeroMatrix[float64](dimension, dimension)
+	for j, k := range order {
+		values[j] = a[k][k]
+		for i := 0; i < dimension; i++ {
+			vectors[i][j] = v[i][k]
+		}
+	}
+	return values, vectors, nil
+}
+
+// jacobiRotate applies the plane rotation that zeroes a[p][q] and a[q][p] to both sides of
+// the symmetric matrix a, accumulating the rotation into the columns of v
+func jacobiRotate(a, v Matrix[float64], p, q int) {
+	if a[p][q] == 0.0 {
+		return
+	}
+	theta := (a[q][q] - a[p][p]) / (2.0 * a[p][q])
+	t := 1.0 / (math.Abs(theta) + math.Sqrt(theta*theta+1.0))
+	if theta < 0 {
+		t = -t
+	}
+	c := 1.0 / math.Sqrt(t*t+1.0)
+	s := t * c
+
+	dimension := len(a)
+	for k := 0; k < dimension; k++ {
+		akp, akq := a[k][p], a[k][q]
+		a[k][p] = c*akp - s*akq
+		a[k][q] = s*akp + c*akq
+	}
+	for k := 0; k < dimension; k++ {
+		apk, aqk := a[p][k], a[q][k]
+		a[p][k] = c*apk - s*aqk
+		a[q][k] = s*apk + c*aqk
+	}
+	a[p][q], a[q][p] = 0.0, 0.0
+
+	for k := 0; k < dimension; k++ {
+		vkp, vkq := v[k][p], v[k][q]
+		v[k][p] = c*vkp - s*vkq
+		v[k][q] = s*vkp + c*vkq
+	}
+}
+
+// offDiagonalNorm returns the square root of the sum of squares of the off diagonal elements of a
+func offDiagonalNorm(a Matrix[float64]) float64 {
+	total := 0.0
+	for i := range a {
+		for j := range a[i] {
+			if i != j {
+				total += a[i][j] * a[i][j]
+			}
+		}
+	}
+	return math.Sqrt(total)
+}
+
+// frobenius returns the square root of the sum of squares of every element of a
+func frobenius(a Matrix[float64]) float64 {
+	total := 0.0
+	for i := range a {
+		for j := range a[i] {
+			total += a[i][j] * a[i][j]
+		}
+	}
+	return math.Sqrt(total)
+}
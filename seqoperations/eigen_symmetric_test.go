@@ -0,0 +1,74 @@
+package seqoperations_test
+
+import (
+	"math"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test EigenSymmetric
+*/
+
+func TestEigenSymmetricTwoByTwo(t *testing.T) {
+	m := seqoperations.Matrix[int]{{2, 1}, {1, 2}}
+	values, vectors, err := m.EigenSymmetric()
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{1, 3}, []float64(values), 1e-12)
+
+	// eigenvectors are only defined up to sign
+	v, _ := vectors.VectorFromColumn(1)
+	dot, _ := v.DotProduct(seqoperations.Vector[float64]{1, 1})
+	assert.InDelta(t, 1.4142135623730951, math.Abs(dot), 1e-12)
+}
+
+func TestEigenSymmetricReconstructs(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{4, 1, -2, 2}, {1, 2, 0, 1}, {-2, 0, 3, -2}, {2, 1, -2, -1}}
+	values, vectors, err := m.EigenSymmetric()
+	assert.Nil(t, err)
+
+	for i := 1; i < len(values); i++ {
+		assert.LessOrEqual(t, values[i-1], values[i])
+	}
+
+	// M * V == V * diag(values)
+	mv, err := m.Multiply(vectors)
+	assert.Nil(t, err)
+	vd := vectors.Copy()
+	for i := range vd {
+		for j := range vd[i] {
+			vd[i][j] *= values[j]
+		}
+	}
+	assert.True(t, vd.WithinSigma(mv, 1e-10))
+
+	vtv, err := vectors.SequentialTranspose().Multiply(vectors)
+	assert.Nil(t, err)
+	assert.True(t, seqoperations.NewIdentityMatrix[float64](4).WithinSigma(vtv, 1e-12))
+}
+
+func TestEigenSymmetricDiagonal(t *testing.T) {
+	m := seqoperations.Matrix[int]{{3, 0, 0}, {0, -1, 0}, {0, 0, 2}}
+	values, vectors, err := m.EigenSymmetric()
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[float64]{-1, 2, 3}, values)
+	assert.Equal(t, seqoperations.Matrix[float64]{{0, 0, 1}, {1, 0, 0}, {0, 1, 0}}, vectors)
+}
+
+func TestEigenSymmetricErrors(t *testing.T) {
+	_, _, err := seqoperations.Matrix[int]{}.EigenSymmetric()
+	assert.Equal(t, e.ErrZeroLength, err)
+
+	_, _, err = seqoperations.Matrix[int]{{1, 2}}.EigenSymmetric()
+	assert.Equal(t, e.ErrNonSquare, err)
+
+	_, _, err = seqoperations.Matrix[int]{{1, 2}, {3, 4}}.EigenSymmetric()
+	assert.Equal(t, e.ErrNotSymmetric, err)
+
+	m := seqoperations.Matrix[float64]{{4, 1, -2, 2}, {1, 2, 0, 1}, {-2, 0, 3, -2}, {2, 1, -2, -1}}
+	_, _, err = m.EigenSymmetric(seqoperations.WithMaxIterations(1), seqoperations.WithTolerance(1e-15))
+	assert.Equal(t, e.ErrNoConvergence, err)
+}
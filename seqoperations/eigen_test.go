@@ -0,0 +1,106 @@
+package seqoperations_test
+
+import (
+	"math"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test Hessenberg
+*/
+
+func TestHessenbergReconstructs(t *testing.T) {
+	m := seqoperations.Matrix[int]{{4, 1, 2, 3}, {2, 3, 1, 0}, {5, 1, 2, 2}, {1, 6, 3, 1}}
+	h, q, err := m.Hessenberg()
+	assert.Nil(t, err)
+	for i := 2; i < 4; i++ {
+		for j := 0; j < i-1; j++ {
+			assert.Equal(t, 0.0, h[i][j])
+		}
+	}
+
+	qh, _ := q.Multiply(h)
+	qhqt, _ := qh.Multiply(q.SequentialTranspose())
+	assert.True(t, m.Float64Copy().WithinSigma(qhqt, 1e-12))
+}
+
+/*
+Test Eigen
+*/
+
+func TestEigenRealEigenvalues(t *testing.T) {
+	m := seqoperations.Matrix[int]{{2, 0, 0}, {1, 3, 0}, {4, 5, 6}}
+	realParts, imaginaryParts, err := m.Eigen()
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{2, 3, 6}, []float64(realParts), 1e-10)
+	assert.InDeltaSlice(t, []float64{0, 0, 0}, []float64(imaginaryParts), 1e-10)
+}
+
+func TestEigenComplexEigenvalues(t *testing.T) {
+	// rotation by 90 degrees has eigenvalues +i and -i
+	m := seqoperations.Matrix[int]{{0, -1}, {1, 0}}
+	realParts, imaginaryParts, err := m.Eigen()
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{0, 0}, []float64(realParts), 1e-12)
+	assert.InDeltaSlice(t, []float64{-1, 1}, []float64(imaginaryParts), 1e-12)
+}
+
+func TestEigenMatchesTraceAndDeterminant(t *testing.T) {
+	m := seqoperations.Matrix[float64]{
+		{0.5, 0.2, 0.1, 0.0, 0.2},
+		{0.1, 0.6, 0.0, 0.3, 0.0},
+		{-0.4, 0.1, 0.3, 0.2, 0.8},
+		{0.0, 0.7, -0.2, 0.1, 0.4},
+		{0.3, 0.0, 0.5, -0.6, 0.2},
+	}
+	realParts, imaginaryParts, err := m.Eigen()
+	assert.Nil(t, err)
+
+	// sum of eigenvalues equals the trace and their product equals the determinant
+	trace, sumReal, sumImaginary := 0.0, 0.0, 0.0
+	productReal, productImaginary := 1.0, 0.0
+	for i := range realParts {
+		trace += m[i][i]
+		sumReal += realParts[i]
+		sumImaginary += imaginaryParts[i]
+		productReal, productImaginary = productReal*realParts[i]-productImaginary*imaginaryParts[i],
+			productReal*imaginaryParts[i]+productImaginary*realParts[i]
+	}
+	det, _ := m.Determinant()
+	assert.InDelta(t, trace, sumReal, 1e-10)
+	assert.InDelta(t, 0.0, sumImaginary, 1e-10)
+	assert.InDelta(t, det, productReal, 1e-10)
+	assert.InDelta(t, 0.0, productImaginary, 1e-10)
+}
+
+func TestEigenAgreesWithEigenSymmetric(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{4, 1, -2, 2}, {1, 2, 0, 1}, {-2, 0, 3, -2}, {2, 1, -2, -1}}
+	realParts, _, err := m.Eigen()
+	assert.Nil(t, err)
+	values, _, err := m.EigenSymmetric()
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64(values), []float64(realParts), 1e-10)
+}
+
+func TestSpectralRadius(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{0.5, 0.5}, {-0.5, 0.5}}
+	radius, err := m.SpectralRadius()
+	assert.Nil(t, err)
+	assert.InDelta(t, math.Sqrt(0.5), radius, 1e-12)
+}
+
+func TestEigenErrors(t *testing.T) {
+	_, _, err := seqoperations.Matrix[int]{}.Eigen()
+	assert.Equal(t, e.ErrZeroLength, err)
+
+	_, _, err = seqoperations.Matrix[int]{{1, 2}}.Eigen()
+	assert.Equal(t, e.ErrNonSquare, err)
+
+	m := seqoperations.Matrix[float64]{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}
+	_, _, err = m.Eigen(seqoperations.WithMaxIterations(1))
+	assert.Equal(t, e.ErrNoConvergence, err)
+}
@@ -0,0 +1,368 @@
+package seqoperations
+
+import "math"
+
+// LinearOperator is a matrix that the iterative solvers only need to multiply by a vector,
+// so large sparse or structured matrices can be used without forming them densely.
+// Matrix, Dense, Sparse, Tridiagonal and Banded all satisfy LinearOperator.
+type LinearOperator interface {
+	Dimensions() (int, int)
+	// ApplyFloat64 returns the product of the operator and x
+	ApplyFloat64(x Vector[float64]) (Vector[float64], error)
+}
+
+// DiagonalOperator is a LinearOperator that can also supply its main diagonal,
+// as needed for Jacobi preconditioning
+type DiagonalOperator interface {
+	LinearOperator
+	// DiagonalFloat64 returns the elements on the main diagonal of the operator,
+	// or an error if the operator is malformed so that its diagonal cannot be read
+	DiagonalFloat64() (Vector[float64], error)
+}
+
+// ConjugateGradient returns x satisfying A * x = b for a symmetric positive definite operator A,
+// along with the number of iterations taken and the final relative residual |b - A * x| / |b|.
+// The iteration starts from zero and stops once the relative residual is no larger than the tolerance.
+// Convergence may be adjusted with WithTolerance and WithMaxIterations; the default iteration limit
+// is usually too small for large systems.
+// errNotPositiveDefinite is returned if a search direction shows A is not positive definite and
+// errNoConvergence is returned, along with the last iterate, if the iteration limit is reached.
+func ConjugateGradient(a LinearOperator, b Vector[float64], options ...IterationOption) (Vector[float64], int, float64, error) {
+	return preconditionedConjugateGradient(a, b, nil, options)
+}
+
+// JacobiConjugateGradient behaves as ConjugateGradient but preconditions the iteration with the
+// diagonal of A, which reduces the iterations needed when the diagonal elements vary widely in size.
+// Any error from reading the diagonal is returned, errDifferentDimension is returned if the diagonal
+// does not have one element per row and errNotPositiveDefinite if a diagonal element is not positive.
+func JacobiConjugateGradient(a DiagonalOperator, b Vector[float64], options ...IterationOption) (Vector[float64], int, float64, error) {
+	if err := checkSystem(a, b); err != nil {
+		return Vector[float64]{}, 0, 0.0, err
+	}
+	diagonal, err := a.DiagonalFloat64()
+	if err != nil {
+		return Vector[float64]{}, 0, 0.0, err
+	}
+	if len(diagonal) != len(b) {
+		return Vector[float64]{}, 0, 0.0, errDifferentDimension
+	}
+	inverse := make(Vector[float64], len(diagonal))
+	for i, element := range diagonal {
+		if element <= 0.0 {
+			return Vector[float64]{}, 0, 0.0, errNotPositiveDefinite
+		}
+		inverse[i] = 1.0 / element
+	}
+	return preconditionedConjugateGradient(a, b, inverse, options)
+}
+
+// preconditionedConjugateGradient carries out the conjugate gradient method, scaling each residual
+// by inverseDiagonal if it is not nil
+func preconditionedConjugateGradient(a LinearOperator, b Vector[float64], inverseDiagonal Vector[float64], options []IterationOption) (Vector[float64], int, float64, error) {
+	if err := checkSystem(a, b); err != nil {
+		return Vector[float64]{}, 0, 0.0, err
+	}
+	settings := newIterationSettings(options)
+	dimension := len(b)
+	x := make(Vector[float64], dimension)
+	bNorm := norm2(b)
+	if bNorm == 0.0 {
+		return x, 0, 0.0, nil
+	}
+
+	precondition := func(r Vector[float64]) Vector[float64] {
+		if inverseDiagonal == nil {
+			return append(Vector[float64]{}, r...)
+		}
+		z := make(Vector[float64], dimension)
+		for i := range r {
+			z[i] = inverseDiagonal[i] * r[i]
+		}
+		return z
+	}
+
+	r := append(Vector[float64]{}, b...)
+	z := precondition(r)
+	p := append(Vector[float64]{}, z...)
+	rz := dot(r, z)
+	for iteration := 0; ; iteration++ {
+		residual := norm2(r) / bNorm
+		if residual <= settings.tolerance {
+			return x, iteration, residual, nil
+		}
+		if iteration == settings.maxIterations {
+			return x, iteration, residual, errNoConvergence
+		}
+
+		ap, err := a.ApplyFloat64(p)
+		if err != nil {
+			return Vector[float64]{}, iteration, 0.0, err
+		}
+		pAp := dot(p, ap)
+		if pAp <= 0.0 {
+			return Vector[float64]{}, iteration, 0.0, errNotPositiveDefinite
+		}
+		alpha := rz / pAp
+		for i := 0; i < dimension; i++ {
+			x[i] += alpha * p[i]
+			r[i] -= alpha * ap[i]
+		}
+
+		z = precondition(r)
+		rzNext := dot(r, z)
+		beta := rzNext / rz
+		rz = rzNext
+		for i := 0; i < dimension; i++ {
+			p[i] = z[i] + beta*p[i]
+		}
+	}
+}
+
+// GMRES returns x satisfying A * x = b for any square non-singular operator A, along with the number
+// of iterations taken and the final relative residual |b - A * x| / |b|.
+// Each iteration extends an orthonormal Krylov basis and x minimises the residual over that basis.
+// The basis is discarded and rebuilt from the current x every DefaultRestart iterations, or as set by
+// WithRestart, which trades memory for convergence speed.
+// Convergence may be adjusted with WithTolerance and WithMaxIterations.
+// errNoInverse is returned if A is found to be singular and errNoConvergence is returned,
+// along with the last iterate, if the iteration limit is reached.
+func GMRES(a LinearOperator, b Vector[float64], options ...IterationOption) (Vector[float64], int, float64, error) {
+	if err := checkSystem(a, b); err != nil {
+		return Vector[float64]{}, 0, 0.0, err
+	}
+	settings := newIterationSettings(options)
+	dimension := len(b)
+	x := make(Vector[float64], dimension)
+	bNorm := norm2(b)
+	if bNorm == 0.0 {
+		return x, 0, 0.0, nil
+	}
+
+	restart := settings.restart
+	if restart > dimension {
+		restart = dimension
+	}
+	// basis holds the Krylov vectors and hessenberg the projection of A onto them, which is reduced
+	// to upper triangular form by the Givens rotations in cosines and sines as it is built
+	basis := make([]Vector[float64], restart+1)
+	hessenberg := NewZeroMatrix[float64](restart+1, restart)
+	cosines, sines := make([]float64, restart), make([]float64, restart)
+	g := make([]float64, restart+1)
+
+	iteration := 0
+	for {
+		r, err := residualVector(a, b, x)
+		if err != nil {
+			return Vector[float64]{}, iteration, 0.0, err
+		}
+		beta := norm2(r)
+		if beta/bNorm <= settings.tolerance {
+			return x, iteration, beta / bNorm, nil
+		}
+		if iteration == settings.maxIterations {
+			return x, iteration, beta / bNorm, errNoConvergence
+		}
+
+		basis[0] = scaled(r, 1.0/beta)
+		for i := range g {
+			g[i] = 0.0
+		}
+		g[0] = beta
+
+		steps := 0
+		for j := 0; j < restart && iteration < settings.maxIterations; j++ {
+			iteration++
+			steps++
+			w, err := a.ApplyFloat64(basis[j])
+			if err != nil {
+				return Vector[float64]{}, iteration, 0.0, err
+			}
+			// modified Gram-Schmidt against the basis so far
+			for i := 0; i <= j; i++ {
+				hessenberg[i][j] = dot(w, basis[i])
+				for k := range w {
+					w[k] -= hessenberg[i][j] * basis[i][k]
+				}
+			}
+			hessenberg[j+1][j] = norm2(w)
+			subdiagonal := hessenberg[j+1][j]
+
+			for i := 0; i < j; i++ {
+				upper, lower := hessenberg[i][j], hessenberg[i+1][j]
+				hessenberg[i][j] = cosines[i]*upper + sines[i]*lower
+				hessenberg[i+1][j] = -sines[i]*upper + cosines[i]*lower
+			}
+			radius := math.Hypot(hessenberg[j][j], hessenberg[j+1][j])
+			if radius == 0.0 {
+				// A maps a basis vector to zero so is singular
+				return x, iteration, beta / bNorm, errNoInverse
+			}
+			cosines[j], sines[j] = hessenberg[j][j]/radius, hessenberg[j+1][j]/radius
+			hessenberg[j][j], hessenberg[j+1][j] = radius, 0.0
+			g[j+1] = -sines[j] * g[j]
+			g[j] = cosines[j] * g[j]
+
+			// a zero subdiagonal means the basis spans the solution exactly
+			if subdiagonal == 0.0 || math.Abs(g[j+1])/bNorm <= settings.tolerance {
+				break
+			}
+			basis[j+1] = scaled(w, 1.0/subdiagonal)
+		}
+
+		// back substitution for the coefficients of the basis vectors
+		y := make([]float64, steps)
+		for i := steps - 1; i >= 0; i-- {
+			total := g[i]
+			for k := i + 1; k < steps; k++ {
+				total -= hessenberg[i][k] * y[k]
+			}
+			y[i] = total / hessenberg[i][i]
+		}
+		for k := 0; k < steps; k++ {
+			for i := range x {
+				x[i] += y[k] * basis[k][i]
+			}
+		}
+	}
+}
+
+// ApplyFloat64 returns the product of the matrix and x, allowing a Matrix to be used as a LinearOperator.
+// errMultiplicationValidity is returned if the length of x does not match the columns of the matrix.
+func (m Matrix[N]) ApplyFloat64(x Vector[float64]) (Vector[float64], error) {
+	if err := m.Validate(); err != nil {
+		return Vector[float64]{}, err
+	}
+	rows, columns := m.Dimensions()
+	if len(x) != columns {
+		return Vector[float64]{}, errMultiplicationValidity
+	}
+	out := make(Vector[float64], rows)
+	for i := 0; i < rows; i++ {
+		total := 0.0
+		for j, element := range m[i] {
+			total += float64(element) * x[j]
+		}
+		out[i] = total
+	}
+	return out, nil
+}
+
+// DiagonalFloat64 returns the main diagonal of the matrix as float64 values.
+// errRagged is returned if the rows of the matrix are not all the same length.
+func (m Matrix[N]) DiagonalFloat64() (Vector[float64], error) {
+	if err := m.Validate(); err != nil {
+		return Vector[float64]{}, err
+	}
+	rows, columns := m.Dimensions()
+	diagonal := make(Vector[float64], minInt(rows, columns))
+	for i := range diagonal {
+		diagonal[i] = float64(m[i][i])
+	}
+	return diagonal, nil
+}
+
+// ApplyFloat64 returns the product of d and x, allowing a Dense to be used as a LinearOperator.
+// errMultiplicationValidity is returned if the length of x does not match the columns of d.
+func (d Dense[N]) ApplyFloat64(x Vector[float64]) (Vector[float64], error) {
+	if len(x) != d.columns {
+		return Vector[float64]{}, errMultiplicationValidity
+	}
+	out := make(Vector[float64], d.rows)
+	for i := 0; i < d.rows; i++ {
+		total := 0.0
+		for j, element := range d.RawRow(i) {
+			total += float64(element) * x[j]
+		}
+		out[i] = total
+	}
+	return out, nil
+}
+
+// DiagonalFloat64 returns the main diagonal of d as float64 values
+func (d Dense[N]) DiagonalFloat64() (Vector[float64], error) {
+	diagonal := make(Vector[float64], minInt(d.rows, d.columns))
+	for i := range diagonal {
+		diagonal[i] = float64(d.data[i*d.stride+i])
+	}
+	return diagonal, nil
+}
+
+// ApplyFloat64 returns the product of s and x, allowing a Sparse to be used as a LinearOperator.
+// errMultiplicationValidity is returned if the length of x does not match the columns of s.
+func (s Sparse[N]) ApplyFloat64(x Vector[float64]) (Vector[float64], error) {
+	if len(x) != s.columns {
+		return Vector[float64]{}, errMultiplicationValidity
+	}
+	out := make(Vector[float64], s.rows)
+	s.forEach(func(i, j int, value N) {
+		out[i] += float64(value) * x[j]
+	})
+	return out, nil
+}
+
+// DiagonalFloat64 returns the main diagonal of s as float64 values
+func (s Sparse[N]) DiagonalFloat64() (Vector[float64], error) {
+	diagonal := make(Vector[float64], minInt(s.rows, s.columns))
+	s.forEach(func(i, j int, value N) {
+		if i == j {
+			diagonal[i] = float64(value)
+		}
+	})
+	return diagonal, nil
+}
+
+// checkSystem returns an error if A * x = b cannot be solved for a square A
+func checkSystem(a LinearOperator, b Vector[float64]) error {
+	rows, columns := a.Dimensions()
+	if rows != columns {
+		return errNonSquare
+	}
+	if rows == 0 {
+		return errZeroLength
+	}
+	if len(b) != rows {
+		return errMultiplicationValidity
+	}
+	return nil
+}
+
+// residualVector returns b - A * x
+func residualVector(a LinearOperator, b, x Vector[float64]) (Vector[float64], error) {
+	ax, err := a.ApplyFloat64(x)
+	if err != nil {
+		return Vector[float64]{}, err
+	}
+	r := make(Vector[float64], len(b))
+	for i := range b {
+		r[i] = b[i] - ax[i]
+	}
+	return r, nil
+}
+
+// scaled returns a copy of v with every element multiplied by factor
+func scaled(v Vector[float64], factor float64) Vector[float64] {
+	out := make(Vector[float64], len(v))
+	for i, element := range v {
+		out[i] = element * factor
+	}
+	return out
+}
+
+func dot(u, v Vector[float64]) float64 {
+	total := 0.0
+	for i := range u {
+		total += u[i] * v[i]
+	}
+	return total
+}
+
+func norm2(v Vector[float64]) float64 {
+	return math.Sqrt(dot(v, v))
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
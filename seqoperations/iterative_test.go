@@ -0,0 +1,181 @@
+package seqoperations_test
+
+import (
+	"math"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+// poisson returns the symmetric positive definite second difference matrix, scaling row i by scale(i)
+// on both sides so that the result stays symmetric
+func poisson(dimension int, scale func(int) float64) seqoperations.Matrix[float64] {
+	m := seqoperations.NewZeroMatrix[float64](dimension, dimension)
+	for i := 0; i < dimension; i++ {
+		m[i][i] = 2.0 * scale(i) * scale(i)
+		if i > 0 {
+			m[i][i-1] = -scale(i) * scale(i-1)
+			m[i-1][i] = -scale(i) * scale(i-1)
+		}
+	}
+	return m
+}
+
+func unitScale(int) float64 { return 1.0 }
+
+func rightHandSide(dimension int) seqoperations.Vector[float64] {
+	b := make(seqoperations.Vector[float64], dimension)
+	for i := range b {
+		b[i] = math.Sin(float64(i + 1))
+	}
+	return b
+}
+
+// assertSolves checks that x satisfies a * x = b to within the relative tolerance
+func assertSolves(t *testing.T, a seqoperations.Matrix[float64], x, b seqoperations.Vector[float64], tolerance float64) {
+	ax, err := a.ApplyFloat64(x)
+	assert.Nil(t, err)
+	residual, bNorm := 0.0, 0.0
+	for i := range b {
+		residual += (b[i] - ax[i]) * (b[i] - ax[i])
+		bNorm += b[i] * b[i]
+	}
+	assert.LessOrEqual(t, math.Sqrt(residual/bNorm), tolerance)
+}
+
+/*
+Test ConjugateGradient
+*/
+
+func TestConjugateGradient(t *testing.T) {
+	a := poisson(60, unitScale)
+	b := rightHandSide(60)
+	dense, err := a.Dense()
+	assert.Nil(t, err)
+	sparse, _ := a.Sparse(seqoperations.CSR)
+
+	for _, operator := range []seqoperations.LinearOperator{a, dense, sparse} {
+		x, iterations, residual, err := seqoperations.ConjugateGradient(operator, b, seqoperations.WithTolerance(1e-10), seqoperations.WithMaxIterations(200))
+		assert.Nil(t, err)
+		assert.LessOrEqual(t, iterations, 60+5)
+		assert.LessOrEqual(t, residual, 1e-10)
+		assertSolves(t, a, x, b, 1e-9)
+	}
+
+	_, iterations, residual, err := seqoperations.ConjugateGradient(a, b, seqoperations.WithMaxIterations(3))
+	assert.Equal(t, e.ErrNoConvergence, err)
+	assert.Equal(t, 3, iterations)
+	assert.Greater(t, residual, 1e-12)
+
+	x, iterations, _, err := seqoperations.ConjugateGradient(a, make(seqoperations.Vector[float64], 60))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, iterations)
+	assert.Equal(t, make(seqoperations.Vector[float64], 60), x)
+}
+
+func TestConjugateGradientErrors(t *testing.T) {
+	_, _, _, err := seqoperations.ConjugateGradient(poisson(3, unitScale).MultiplyElementsBy(-1), rightHandSide(3))
+	assert.Equal(t, e.ErrNotPositiveDefinite, err)
+	_, _, _, err = seqoperations.ConjugateGradient(seqoperations.NewZeroMatrix[float64](2, 3), rightHandSide(2))
+	assert.Equal(t, e.ErrNonSquare, err)
+	_, _, _, err = seqoperations.ConjugateGradient(poisson(3, unitScale), rightHandSide(4))
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+	_, _, _, err = seqoperations.ConjugateGradient(seqoperations.Matrix[float64]{}, seqoperations.Vector[float64]{})
+	assert.Equal(t, e.ErrZeroLength, err)
+	_, _, _, err = seqoperations.JacobiConjugateGradient(seqoperations.Matrix[float64]{{0, 1}, {1, 0}}, rightHandSide(2))
+	assert.Equal(t, e.ErrNotPositiveDefinite, err)
+
+	// the shape of the system is checked before the diagonal is read, and reading it reports a ragged matrix
+	_, _, _, err = seqoperations.JacobiConjugateGradient(seqoperations.Matrix[float64]{{0, 1, 2}, {1, 0, 3}}, rightHandSide(2))
+	assert.Equal(t, e.ErrNonSquare, err)
+	_, _, _, err = seqoperations.JacobiConjugateGradient(seqoperations.Matrix[float64]{{1, 0}, {0}}, rightHandSide(2))
+	assert.Equal(t, e.ErrRagged, err)
+	_, _, _, err = seqoperations.JacobiConjugateGradient(poisson(3, unitScale), rightHandSide(4))
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+
+	// the diagonal an operator supplies is checked before it is used
+	_, _, _, err = seqoperations.JacobiConjugateGradient(shortDiagonal{poisson(3, unitScale)}, rightHandSide(3))
+	assert.Equal(t, e.ErrDifferentDimension, err)
+}
+
+// shortDiagonal is an operator whose DiagonalFloat64 omits the last element
+type shortDiagonal struct {
+	seqoperations.Matrix[float64]
+}
+
+func (s shortDiagonal) DiagonalFloat64() (seqoperations.Vector[float64], error) {
+	diagonal, err := s.Matrix.DiagonalFloat64()
+	return diagonal[:len(diagonal)-1], err
+}
+
+func TestDiagonalFloat64(t *testing.T) {
+	diagonal, err := seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}}.DiagonalFloat64()
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[float64]{1, 5}, diagonal)
+
+	_, err = seqoperations.Matrix[int]{{1, 2}, {3}}.DiagonalFloat64()
+	assert.Equal(t, e.ErrRagged, err)
+}
+
+/*
+Test JacobiConjugateGradient
+*/
+
+func TestJacobiConjugateGradientReducesIterations(t *testing.T) {
+	// rows scaled over several orders of magnitude are poorly conditioned until the diagonal is divided out
+	a := poisson(40, func(i int) float64 { return math.Pow(10, float64(i%5)) })
+	b := rightHandSide(40)
+	options := []seqoperations.IterationOption{seqoperations.WithTolerance(1e-10), seqoperations.WithMaxIterations(1000)}
+
+	_, plainIterations, _, err := seqoperations.ConjugateGradient(a, b, options...)
+	assert.Nil(t, err)
+
+	dense, _ := a.Dense()
+	sparse, _ := a.Sparse(seqoperations.CSC)
+	for _, operator := range []seqoperations.DiagonalOperator{a, dense, sparse} {
+		x, iterations, residual, err := seqoperations.JacobiConjugateGradient(operator, b, options...)
+		assert.Nil(t, err)
+		assert.LessOrEqual(t, residual, 1e-10)
+		assert.Less(t, iterations, plainIterations)
+		assertSolves(t, a, x, b, 1e-9)
+	}
+}
+
+/*
+Test GMRES
+*/
+
+func TestGMRES(t *testing.T) {
+	// a non-symmetric, diagonally dominant matrix
+	dimension := 50
+	a := seqoperations.NewZeroMatrix[float64](dimension, dimension)
+	for i := 0; i < dimension; i++ {
+		a[i][i] = 4.0
+		if i > 0 {
+			a[i][i-1] = -1.0
+		}
+		if i+2 < dimension {
+			a[i][i+2] = 1.5
+		}
+	}
+	b := rightHandSide(dimension)
+	sparse, _ := a.Sparse(seqoperations.CSR)
+
+	for _, restart := range []int{5, 30, 100} {
+		for _, operator := range []seqoperations.LinearOperator{a, sparse} {
+			x, _, residual, err := seqoperations.GMRES(operator, b, seqoperations.WithTolerance(1e-10), seqoperations.WithRestart(restart), seqoperations.WithMaxIterations(500))
+			assert.Nil(t, err)
+			assert.LessOrEqual(t, residual, 1e-10)
+			assertSolves(t, a, x, b, 1e-9)
+		}
+	}
+
+	_, iterations, _, err := seqoperations.GMRES(a, b, seqoperations.WithMaxIterations(2))
+	assert.Equal(t, e.ErrNoConvergence, err)
+	assert.Equal(t, 2, iterations)
+
+	_, _, _, err = seqoperations.GMRES(seqoperations.Matrix[float64]{{1, 0}, {0, 0}}, seqoperations.Vector[float64]{0, 1})
+	assert.Equal(t, e.ErrNoInverse, err)
+}
@@ -0,0 +1,156 @@
+package seqoperations
+
+import "math"
+
+// LU returns the LU decomposition of a square matrix computed with partial pivoting.
+// The result satisfies P * M = L * U where L is unit lower triangular, U is upper triangular
+// and P is the permutation described by perm: row i of P * M is row perm[i] of M.
+// An error is returned if the matrix is empty, non-square or singular.
+func (m Matrix[N]) LU() (Matrix[float64], Matrix[float64], []int, error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, Matrix[float64]{}, nil, err
+	}
+	lu, perm, err := luFactoriseNonSingular(m.float64Copy())
+	if err != nil {
+		return Matrix[float64]{}, Matrix[float64]{}, nil, err
+	}
+
+	dimension := len(lu)
+	l := NewIdentityMatrix[float64](dimension)
+	u := NewZeroMatrix[float64](dimension, dimension)
+	for i := 0; i < dimension; i++ {
+		for j := 0; j < dimension; j++ {
+			if j < i {
+				l[i][j] = lu[i][j]
+			} else {
+				u[i][j] = lu[i][j]
+			}
+		}
+	}
+	return l, u, perm, nil
+}
+
+// luFactorise carries out LU decomposition with partial pivoting in place on matrix a.
+// On return the strict lower triangle of a holds the multipliers of L and the upper triangle holds U.
+// The row permutation and its sign are also returned for use by solvers and the determinant.
+// errNoInverse is returned as soon as a column with no non zero pivot is found.
+func luFactorise(a Matrix[float64]) (Matrix[float64], []int, float64, error) {
+	if !a.IsSquare() {
+		return nil, nil, 0.0, errNonSquare
+	}
+	dimension := len(a)
+	if dimension == 0 {
+		return nil, nil, 0.0, errZeroLength
+	}
+
+	perm := make([]int, dimension)
+	for i := range perm {
+		perm[i] = i
+	}
+	sign := 1.0
+
+	for k := 0; k < dimension; k++ {
+		pivot := a.FindMaxPivot(k, k)
+		if pivot == -1 {
+			return a, perm, 0.0, errNoInverse
+		}
+		if pivot != k {
+			// rows are known to be in bounds so the error can be discarded
+			_ = a.SwapRows(k, pivot)
+			perm[k], perm[pivot] = perm[pivot], perm[k]
+			sign = -sign
+		}
+		for i := k + 1; i < dimension; i++ {
+			factor := a[i][k] / a[k][k]
+			a[i][k] = factor
+			for j := k + 1; j < dimension; j++ {
+				a[i][j] -= factor * a[k][j]
+			}
+		}
+	}
+	return a, perm, sign, nil
+}
+
+// luFactoriseNonSingular carries out luFactorise in place on matrix a and additionally returns
+// errNoInverse if any pivot is small enough relative to the elements of its own row of a to be treated as zero.
+// Each pivot is measured against its own row so matrices whose rows differ greatly in scale are still inverted.
+func luFactoriseNonSingular(a Matrix[float64]) (Matrix[float64], []int, error) {
+	tolerances := rowTolerances(a)
+	lu, perm, _, err := luFactorise(a)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, row := range perm {
+		if math.Abs(lu[i][i]) <= tolerances[row] {
+			return nil, nil, errNoInverse
+		}
+	}
+	return lu, perm, nil
+}
+
+// luSolve solves L * U * x = P * b for x using forward and back substitution,
+// where lu and perm are the outputs of luFactorise
+func luSolve(lu Matrix[float64], perm []int, b []float64) []float64 {
+	dimension := len(lu)
+	x := make([]float64, dimension)
+	for i := 0; i < dimension; i++ {
+		total := b[perm[i]]
+		for j := 0; j < i; j++ {
+			total -= lu[i][j] * x[j]
+		}
+		x[i] = total
+	}
+	for i := dimension - 1; i >= 0; i-- {
+		total := x[i]
+		for j := i + 1; j < dimension; j++ {
+			total -= lu[i][j] * x[j]
+		}
+		x[i] = total / lu[i][i]
+	}
+	return x
+}
+
+// hasSmallPivot returns true if any diagonal entry of a factorised matrix is
+// no larger than tolerance in absolute value
+func (m Matrix[N]) hasSmallPivot(tolerance float64) bool {
+	rows, columns := m.Dimensions()
+	for i := 0; i < rows && i < columns; i++ {
+		if math.Abs(float64(m[i][i])) <= tolerance {
+			return true
+		}
+	}
+	return false
+}
+
+// rowTolerances returns for each row of the supplied matrix the magnitude below which
+// a pivot taken from that row is treated as zero, scaled by the row length and its largest element
+func rowTolerances(m Matrix[float64]) []float64 {
+	tolerances := make([]float64, len(m))
+	for i, row := range m {
+		largest := 0.0
+		for _, element := range row {
+			largest = math.Max(largest, math.Abs(element))
+		}
+		tolerances[i] = float64(len(row)) * largest * machineEpsilon
+	}
+	return tolerances
+}
+
+// singularTolerance returns the magnitude below which a pivot of the supplied matrix
+// is treated as zero, scaled by the larger matrix dimension and its largest element
+func singularTolerance(m Matrix[float64]) float64 {
+	largest := 0.0
+	for _, row := range m {
+		for _, element := range row {
+			largest = math.Max(largest, math.Abs(element))
+		}
+	}
+	rows, columns := m.Dimensions()
+	if columns > rows {
+		rows = columns
+	}
+	return float64(rows) * largest * machineEpsilon
+}
+
+// machineEpsilon is the difference between 1.0 and the next representable float64
+const machineEpsilon = 2.220446049250313e-16
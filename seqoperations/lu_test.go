@@ -0,0 +1,135 @@
+package seqoperations_test
+
+import (
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test LU
+*/
+
+func TestLUReconstructsPermutedMatrix(t *testing.T) {
+	m := seqoperations.Matrix[int]{{0, 2, 1}, {4, 1, -2}, {2, 3, 5}}
+	l, u, perm, err := m.LU()
+	assert.Nil(t, err)
+
+	lu, err := l.Multiply(u)
+	assert.Nil(t, err)
+	pm := seqoperations.NewZeroMatrix[float64](3, 3)
+	for i, p := range perm {
+		for j := 0; j < 3; j++ {
+			pm[i][j] = float64(m[p][j])
+		}
+	}
+	assert.True(t, pm.WithinSigma(lu, 1e-12))
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, 1.0, l[i][i])
+		for j := i + 1; j < 3; j++ {
+			assert.Equal(t, 0.0, l[i][j])
+			assert.Equal(t, 0.0, u[j][i])
+		}
+	}
+}
+
+func TestLUChoosesLargestPivot(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{1, 2}, {3, 4}}
+	l, u, perm, err := m.LU()
+	assert.Nil(t, err)
+	assert.Equal(t, []int{1, 0}, perm)
+	assert.True(t, seqoperations.Matrix[float64]{{1, 0}, {1.0 / 3.0, 1}}.WithinSigma(l, 1e-12))
+	assert.True(t, seqoperations.Matrix[float64]{{3, 4}, {0, 2.0 / 3.0}}.WithinSigma(u, 1e-12))
+}
+
+func TestLUErrors(t *testing.T) {
+	_, _, _, err := seqoperations.Matrix[int]{}.LU()
+	assert.Equal(t, e.ErrZeroLength, err)
+
+	_, _, _, err = seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}}.LU()
+	assert.Equal(t, e.ErrNonSquare, err)
+
+	_, _, _, err = seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}.LU()
+	assert.Equal(t, e.ErrNoInverse, err)
+
+	_, _, _, err = seqoperations.Matrix[int]{{0, 1}, {0, 2}}.LU()
+	assert.Equal(t, e.ErrNoInverse, err)
+}
+
+func TestFindMaxPivot(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 0}, {-7, 0}, {5, 0}}
+	assert.Equal(t, 1, m.FindMaxPivot(0, 0))
+	assert.Equal(t, 2, m.FindMaxPivot(2, 0))
+	assert.Equal(t, -1, m.FindMaxPivot(0, 1))
+}
+
+/*
+Test Inverse
+*/
+
+func TestInverseNeedsPivoting(t *testing.T) {
+	// Gauss-Jordan without row exchanges divides by the zero in the top left
+	m := seqoperations.Matrix[int]{{0, 1}, {1, 0}}
+	inv, err := m.Inverse()
+	assert.Nil(t, err)
+	assert.True(t, seqoperations.Matrix[float64]{{0, 1}, {1, 0}}.WithinSigma(inv, 1e-12))
+}
+
+func TestInverseThreeByThree(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}
+	inv, err := m.Inverse()
+	assert.Nil(t, err)
+	e := seqoperations.Matrix[float64]{{-2.0 / 3.0, -4.0 / 3.0, 1}, {-2.0 / 3.0, 11.0 / 3.0, -2}, {1, -2, 1}}
+	assert.True(t, e.WithinSigma(inv, 1e-12))
+}
+
+func TestInverseDoesNotModifyInput(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{2, 1}, {1, 3}}
+	_, err := m.InverseAssumeFloat64Input()
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[float64]{{2, 1}, {1, 3}}, m)
+}
+
+func TestInverseErrors(t *testing.T) {
+	_, err := seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}.Inverse()
+	assert.Equal(t, e.ErrNoInverse, err)
+
+	_, err = seqoperations.Matrix[int]{{1, 2}, {3, 4}, {5, 6}}.Inverse()
+	assert.Equal(t, e.ErrNonSquare, err)
+
+	_, err = seqoperations.Matrix[int]{{1}}.InverseAssumeFloat64Input()
+	assert.Equal(t, e.ErrNotFloat64, err)
+}
+
+func TestBadlyScaledMatrixIsInvertible(t *testing.T) {
+	// every pivot is far below the largest element but large relative to its own row
+	m := seqoperations.Matrix[float64]{{1e10, 0}, {0, 1e-10}}
+	inv, err := m.Inverse()
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{1e-10, 0}, []float64(inv[0]), 1e-22)
+	assert.InDeltaSlice(t, []float64{0, 1e10}, []float64(inv[1]), 1e-2)
+
+	x, err := m.Solve(seqoperations.Vector[float64]{1e10, 1e-10})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{1, 1}, []float64(x), 1e-12)
+
+	_, err = m.SolveMatrix(seqoperations.Matrix[float64]{{1}, {1}})
+	assert.Nil(t, err)
+	_, _, _, err = m.LU()
+	assert.Nil(t, err)
+	det, err := m.Determinant()
+	assert.Nil(t, err)
+	assert.InDelta(t, 1.0, det, 1e-12)
+}
+
+func TestFloat64CopyNonSquare(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}}
+	assert.Equal(t, seqoperations.Matrix[float64]{{1, 2, 3}, {4, 5, 6}}, m.Float64Copy())
+	assert.Equal(t, m, m.Copy())
+
+	n := seqoperations.Matrix[int]{{1}, {2}, {3}}
+	assert.Equal(t, seqoperations.Matrix[float64]{{1}, {2}, {3}}, n.Float64Copy())
+}
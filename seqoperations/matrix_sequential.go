@@ -18,9 +18,71 @@ func (m Matrix[N]) IsSquare() bool {
 	return rows == columns
 }
 
+// Validate returns errRagged if the rows of m are not all the same length.
+// Dimensions inspects only the first row, so operations check their input with Validate
+// before indexing into it.
+func (m Matrix[N]) Validate() error {
+	for i := 1; i < len(m); i++ {
+		if len(m[i]) != len(m[0]) {
+			return errRagged
+		}
+	}
+	return nil
+}
+
+// validate returns errRagged if any of the supplied matrices is ragged
+func validate[N Number](matrices ...Matrix[N]) error {
+	for _, m := range matrices {
+		if err := m.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsSymmetric returns true if matrix is square and Mij equals Mji for every element, false otherwise.
+// Float elements need only satisfy |Mij - Mji| <= n * eps * max|Mkl|, so that the rounding error left
+// in a computed matrix such as a covariance matrix is tolerated, while integer elements must match exactly.
+// An empty matrix with dimensions 0x0 is assumed to be symmetric and a ragged matrix is not symmetric.
+func (m Matrix[N]) IsSymmetric() bool {
+	if m.Validate() != nil || !m.IsSquare() {
+		return false
+	}
+	dimension := len(m)
+	float := isFloat[N]()
+	tolerance := 0.0
+	if float {
+		tolerance = float64(dimension) * m.maxAbs() * machineEpsilon
+	}
+	for i := 0; i < dimension; i++ {
+		for j := i + 1; j < dimension; j++ {
+			if !float && m[i][j] != m[j][i] {
+				return false
+			}
+			if float && math.Abs(float64(m[i][j])-float64(m[j][i])) > tolerance {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isFloat returns true if N is a floating point type
+func isFloat[N Number]() bool {
+	switch any(N(0)).(type) {
+	case float32, float64:
+		return true
+	}
+	return false
+}
+
 // MapFunctionToElements takes fn: a function that returns a result of operation on one element of matrix m,
-// x: a second argument for fn and returns new matrix with same operation applied to every element
+// x: a second argument for fn and returns new matrix with same operation applied to every element.
+// An empty matrix is returned if m is ragged.
 func (m Matrix[N]) MapFunctionToElements(fn ConstantSequentialOperater[N]) Matrix[N] {
+	if m.Validate() != nil {
+		return Matrix[N]{}
+	}
 	rows, columns := m.Dimensions()
 	output := NewZeroMatrix[N](rows, columns)
 	for i := 0; i < rows; i++ {
@@ -34,6 +96,9 @@ func (m Matrix[N]) MapFunctionToElements(fn ConstantSequentialOperater[N]) Matri
 // MapFunctionToElementsInRowInPlace takes a function to be applied to every element of a row
 // and a row number and then applies the function in place
 func (m Matrix[N]) MapFunctionToElementsInRowInPlace(fn ConstantSequentialOperater[N], row int) error {
+	if err := m.Validate(); err != nil {
+		return err
+	}
 	rows, cols := m.Dimensions()
 	if row >= rows || row < 0 {
 		return errRowColSuppliedOutBounds
@@ -53,6 +118,9 @@ func (m Matrix[N]) RowScalarMultiply(row int, multiplier N) error {
 // takes fn: a function that is applied element wise to each element Mij and Nij.
 // in the resulting matrix : Pij = fn(Mij, Nij)
 func (m Matrix[N]) ApplyOneToOne(n Matrix[N], fn OneToOneSequentialOperater[N]) (Matrix[N], error) {
+	if err := validate(m, n); err != nil {
+		return nil, err
+	}
 	rows, columns := m.Dimensions()
 	rowsCheck, columnsCheck := n.Dimensions()
 	if (rows != rowsCheck) || (columns != columnsCheck) {
@@ -135,16 +203,16 @@ func (m Matrix[N]) ElementWiseDivide(n Matrix[N]) (Matrix[N], error) {
 	return out, nil
 }
 
-// Mean returns float64 mean of matrix m if m contains elements
+// Mean returns float64 mean of matrix m if m contains elements and is not ragged
 func (m Matrix[N]) Mean() (float64, bool) {
 
 	rows, columns := m.Dimensions()
 	var total float64
-	if rows == 0 || columns == 0 {
+	if rows == 0 || columns == 0 || m.Validate() != nil {
 		return total, false
 	}
 
-	mCopy := m.Float64Copy()
+	mCopy := m.float64Copy()
 	for i := 0; i < rows; i++ {
 		for j := 0; j < columns; j++ {
 			total += mCopy[i][j]
@@ -155,17 +223,17 @@ func (m Matrix[N]) Mean() (float64, bool) {
 }
 
 // MeanStandardDev returns the mean and standard deviation of
-// a matrix if it contains elements
+// a matrix if it contains elements and is not ragged
 func (m Matrix[N]) MeanStandardDev() (float64, float64, bool) {
 
 	rows, columns := m.Dimensions()
-	if rows == 0 || columns == 0 {
+	if rows == 0 || columns == 0 || m.Validate() != nil {
 		return 0.0, 0.0, false
 	}
 
 	// instantiate total to sum elements in matrix
 	var total float64
-	mCopy := m.Float64Copy()
+	mCopy := m.float64Copy()
 	for i := 0; i < rows; i++ {
 		for j := 0; j < columns; j++ {
 			total += mCopy[i][j]
@@ -189,79 +257,151 @@ func (m Matrix[N]) MeanStandardDev() (float64, float64, bool) {
 	return mean, stdev, true
 }
 
-// Multiply returns matrix P = M * N if multiplication is valid
+// Multiply returns matrix P = M * N if multiplication is valid.
+// N is transposed once so that both operands are read along contiguous rows, and the
+// product is accumulated in square blocks of multiplyBlockSize so that the rows in use stay in cache.
 func (m Matrix[N]) Multiply(n Matrix[N]) (Matrix[N], error) {
 
+	if err := validate(m, n); err != nil {
+		return nil, err
+	}
 	rows, columns, ok := m.MultiplicationDimensions(n)
 	if !ok {
 		return nil, errMultiplicationValidity
 	}
 
+	inner := len(n)
+	nT := n.transpose()
 	out := NewZeroMatrix[N](rows, columns)
-	for i := 0; i < rows; i++ {
-		// Validity of multiplication handled at start of method, booleans from vector ops are discarded
-		rowVector, _ := m.VectorFromRow(i)
-		for j := 0; j < columns; j++ {
-			columnVector, _ := n.VectorFromColumn(j)
-			out[i][j], _ = rowVector.DotProduct(columnVector)
+	for iBlock := 0; iBlock < rows; iBlock += multiplyBlockSize {
+		iMax := blockEnd(iBlock, rows)
+		for jBlock := 0; jBlock < columns; jBlock += multiplyBlockSize {
+			jMax := blockEnd(jBlock, columns)
+			for kBlock := 0; kBlock < inner; kBlock += multiplyBlockSize {
+				kMax := blockEnd(kBlock, inner)
+				for i := iBlock; i < iMax; i++ {
+					rowVector, outRow := m[i][kBlock:kMax], out[i]
+					for j := jBlock; j < jMax; j++ {
+						columnVector := nT[j][kBlock:kMax]
+						total := outRow[j]
+						for k, element := range rowVector {
+							total += element * columnVector[k]
+						}
+						outRow[j] = total
+					}
+				}
+			}
 		}
 	}
 
 	return out, nil
 }
 
+// MulVec returns the vector M * v, treating v as a column.
+// Each element is the dot product of a row of M with v, so no intermediate matrices are built.
+// errMultiplicationValidity is returned if the length of v does not match the columns of M.
+func (m Matrix[N]) MulVec(v Vector[N]) (Vector[N], error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	rows, columns := m.Dimensions()
+	if len(v) != columns {
+		return nil, errMultiplicationValidity
+	}
+	out := NewZeroVector[N](rows)
+	for i, row := range m {
+		var total N
+		for j, element := range row {
+			total += element * v[j]
+		}
+		out[i] = total
+	}
+	return out, nil
+}
+
+// VecMul returns the vector v * M, treating v as a row.
+// Rows of M are scaled by the elements of v and accumulated, so M is read along contiguous rows.
+// errMultiplicationValidity is returned if the length of v does not match the rows of M.
+func (m Matrix[N]) VecMul(v Vector[N]) (Vector[N], error) {
+	if err := m.Validate(); err != nil {
+		return nil, err
+	}
+	rows, columns := m.Dimensions()
+	if len(v) != rows {
+		return nil, errMultiplicationValidity
+	}
+	out := NewZeroVector[N](columns)
+	for i, row := range m {
+		scale := v[i]
+		for j, element := range row {
+			out[j] += scale * element
+		}
+	}
+	return out, nil
+}
+
+// multiplyBlockSize is the side length of the square blocks used by Multiply
+const multiplyBlockSize = 64
+
+// blockEnd returns the exclusive end of the block starting at start, limited by length
+func blockEnd(start, length int) int {
+	if start+multiplyBlockSize < length {
+		return start + multiplyBlockSize
+	}
+	return length
+}
+
 func (m Matrix[N]) Inverse() (Matrix[float64], error) {
 	return m.InverseAssumeAnyTypeInput()
 }
 
 // InverseAssumeAnyTypeInput returns a float64 matrix representing the inverse of the supplied matrix.
 func (m Matrix[N]) InverseAssumeAnyTypeInput() (Matrix[float64], error) {
-	matrix := m.Float64Copy()
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, err
+	}
+	matrix := m.float64Copy()
 	return matrix.InverseAssumeFloat64Input()
 }
 
 // InverseAssumeFloat64Input returns a float64 matrix representing the inverse of the supplied matrix.
 // The method requires a float64 matrix to operate.
+// The inverse is found from an LU decomposition with partial pivoting and the supplied matrix is not modified.
 func (m Matrix[N]) InverseAssumeFloat64Input() (Matrix[N], error) {
 
-	det, err := m.DeterminantAssumeFloat64Input() // this will catch N != float64
+	checkType := N(0)
+	isAssumedInputType := IsFloat64(checkType)
+	if !isAssumedInputType {
+		return Matrix[N]{}, errNotFloat64
+	}
+	// N must be type float64 if this line is reached
+	if err := m.Validate(); err != nil {
+		return Matrix[N]{}, err
+	}
+	matrix := any(m.clone()).(Matrix[float64])
 
-	if (err == errZeroLength) || (err == errNonSquare) || (err == errNotFloat64) {
+	lu, perm, err := luFactoriseNonSingular(matrix)
+	if (err == errZeroLength) || (err == errNonSquare) || (err == errNoInverse) {
 		return Matrix[N]{}, err
 	}
 	if err != nil {
 		return Matrix[N]{}, errUnexpected
 	}
-	if det == 0 {
-		return Matrix[N]{}, errNoInverse
-	}
-
-	// instantiate inverse matrix
-	dimension := len(m)
-	inverseMatrix := NewIdentityMatrix[N](dimension)
 
-	// Apply Gauss-Jordan elimination to find the inverse
-	for i := 0; i < dimension; i++ {
-		// Divide row i by matrix[i][i]
-		factor := 1.0 / m[i][i]
-		for j := 0; j < dimension; j++ {
-			m[i][j] *= factor
-			inverseMatrix[i][j] *= factor
-		}
-
-		// Subtract row i from all other rows to make matrix[i][i] equal to 1
-		for j := 0; j < dimension; j++ {
-			if j != i {
-				factor := m[j][i]
-				for k := 0; k < dimension; k++ {
-					m[j][k] -= factor * m[i][k]
-					inverseMatrix[j][k] -= factor * inverseMatrix[i][k]
-				}
-			}
+	// solve for each column of the identity matrix in turn
+	dimension := len(lu)
+	inverseMatrix := NewZeroMatrix[float64](dimension, dimension)
+	unit := make([]float64, dimension)
+	for j := 0; j < dimension; j++ {
+		unit[j] = 1.0
+		column := luSolve(lu, perm, unit)
+		for i := 0; i < dimension; i++ {
+			inverseMatrix[i][j] = column[i]
 		}
+		unit[j] = 0.0
 	}
 
-	return inverseMatrix, nil
+	return any(inverseMatrix).(Matrix[N]), nil
 }
 
 // Determinant returns the determinant of a matrix as a float64 value
@@ -272,7 +412,10 @@ func (m Matrix[N]) Determinant() (float64, error) {
 // DeterminantAssumeAnyTypeInput returns the determinant of a matrix of
 // any number type as a float64 value
 func (m Matrix[N]) DeterminantAssumeAnyTypeInput() (float64, error) {
-	matrix := m.Float64Copy()
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	matrix := m.float64Copy()
 	return matrix.DeterminantAssumeFloat64Input()
 }
 
@@ -288,6 +431,9 @@ func (m Matrix[N]) DeterminantAssumeFloat64Input() (N, error) {
 		return 0.0, errNotFloat64
 	}
 	// N must be type float64 if this line is reached
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
 
 	// return errors if determinant does not exist
 	isSquare := m.IsSquare()
@@ -300,35 +446,22 @@ func (m Matrix[N]) DeterminantAssumeFloat64Input() (N, error) {
 		return 0.0, errZeroLength
 	}
 
-	if n == 1 {
-		return m[0][0], nil
+	// reduce a copy to upper triangular form, the determinant is then the product of the
+	// diagonal, negated once for every row exchange made while pivoting
+	matrix := any(m.clone()).(Matrix[float64])
+	lu, _, sign, err := luFactorise(matrix)
+	if err == errNoInverse {
+		return 0.0, nil
 	}
-
-	if n == 2 {
-		return m[0][0]*m[1][1] - m[0][1]*m[1][0], nil
+	if err != nil {
+		return 0.0, errUnexpected
 	}
 
-	det := N(0)
+	det := sign
 	for i := 0; i < n; i++ {
-		submatrix := NewZeroMatrix[N](n-1, n-1)
-		for j := 1; j < n; j++ {
-			for k := 0; k < n; k++ {
-				if k < i {
-					submatrix[j-1][k] = m[j][k]
-				} else if k > i {
-					submatrix[j-1][k-1] = m[j][k]
-				}
-			}
-		}
-
-		sign := N(1)
-		if i%2 != 0 {
-			sign = -N(1)
-		}
-		subMatrixDeterminant, _ := submatrix.DeterminantAssumeFloat64Input()
-		det += sign * m[0][i] * subMatrixDeterminant
+		det *= lu[i][i]
 	}
-	return det, nil
+	return N(det), nil
 }
 
 // SwapRows swaps row1 and row 2 of matrix m in situ
@@ -354,8 +487,28 @@ func (m Matrix[N]) FindPivot(row, column int) int {
 	return -1
 }
 
+// FindMaxPivot returns the index of the row, at or below the supplied row, holding the
+// largest absolute value in the supplied column. -1 is returned if all such values are zero.
+func (m Matrix[N]) FindMaxPivot(row, column int) int {
+	rows, _ := m.Dimensions()
+	pivot, largest := -1, N(0)
+	for i := row; i < rows; i++ {
+		value := m[i][column]
+		if value < N(0) {
+			value = -value
+		}
+		if value > largest {
+			pivot, largest = i, value
+		}
+	}
+	return pivot
+}
+
 // SubMatrix returns a copied sub matrix
 func (m Matrix[N]) SubMatrix(rowMin, colMin, rowMax, colMax int) (Matrix[N], error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[N]{}, err
+	}
 	rows, columns := m.Dimensions()
 	if rowMin < 0 || rowMin > rowMax || rowMax >= rows || colMin < 0 || colMin > colMax || colMax >= columns {
 		return Matrix[N]{}, errRowColSuppliedOutBounds
@@ -369,32 +522,59 @@ func (m Matrix[N]) SubMatrix(rowMin, colMin, rowMax, colMax int) (Matrix[N], err
 	return submatrix, nil
 }
 
-// Copy returns a copy of supplied matrix
+// Copy returns a copy of supplied matrix.
+// An empty matrix is returned if m is ragged.
 func (m Matrix[N]) Copy() Matrix[N] {
+	if m.Validate() != nil {
+		return Matrix[N]{}
+	}
+	return m.clone()
+}
+
+// clone returns a copy of m, which must not be ragged
+func (m Matrix[N]) clone() Matrix[N] {
 	rows, columns := m.Dimensions()
 	copy := NewZeroMatrix[N](rows, columns)
 	for i := 0; i < rows; i++ {
-		for j := 0; j < rows; j++ {
+		for j := 0; j < columns; j++ {
 			copy[i][j] = m[i][j]
 		}
 	}
 	return copy
 }
 
-// Float64Copy returns a copy of the provided matrix with all Number N converted to float64
+// Float64Copy returns a copy of the provided matrix with all Number N converted to float64.
+// An empty matrix is returned if m is ragged.
 func (m Matrix[N]) Float64Copy() Matrix[float64] {
+	if m.Validate() != nil {
+		return Matrix[float64]{}
+	}
+	return m.float64Copy()
+}
+
+// float64Copy returns a copy of m with every element converted to float64, m must not be ragged
+func (m Matrix[N]) float64Copy() Matrix[float64] {
 	rows, columns := m.Dimensions()
 	copy := NewZeroMatrix[float64](rows, columns)
 	for i := 0; i < rows; i++ {
-		for j := 0; j < rows; j++ {
+		for j := 0; j < columns; j++ {
 			copy[i][j] = float64(m[i][j])
 		}
 	}
 	return copy
 }
 
-// SequentialTranspose returns the transpose of a matrix
+// SequentialTranspose returns the transpose of a matrix.
+// An empty matrix is returned if m is ragged.
 func (m Matrix[N]) SequentialTranspose() Matrix[N] {
+	if m.Validate() != nil {
+		return Matrix[N]{}
+	}
+	return m.transpose()
+}
+
+// transpose returns the transpose of m, which must not be ragged
+func (m Matrix[N]) transpose() Matrix[N] {
 	x, y := m.Dimensions()
 	t := NewZeroMatrix[N](y, x)
 	entries := x * y
@@ -413,13 +593,13 @@ func (m Matrix[N]) SameDimensions(n Matrix[N]) bool {
 }
 
 // WithinSigma returns true if m and n have the same dimensions and for each element
-// sigma > | Mij - Nij |
+// sigma > | Mij - Nij |. False is returned if either matrix is ragged.
 func (m Matrix[N]) WithinSigma(n Matrix[N], sigma float64) bool {
 	sameDimensions := m.SameDimensions(n)
-	if !sameDimensions {
+	if !sameDimensions || validate(m, n) != nil {
 		return false
 	}
-	m64, n64 := m.Float64Copy(), n.Float64Copy()
+	m64, n64 := m.float64Copy(), n.float64Copy()
 	rows, columns := m.Dimensions()
 	for i := 0; i < rows; i++ {
 		for j := 0; j < columns; j++ {
@@ -0,0 +1,176 @@
+package seqoperations_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+// naiveMultiply is the original row by column implementation of Multiply, kept as a reference
+func naiveMultiply[N seqoperations.Number](m, n seqoperations.Matrix[N]) seqoperations.Matrix[N] {
+	rows, columns, _ := m.MultiplicationDimensions(n)
+	out := seqoperations.NewZeroMatrix[N](rows, columns)
+	for i := 0; i < rows; i++ {
+		rowVector, _ := m.VectorFromRow(i)
+		for j := 0; j < columns; j++ {
+			columnVector, _ := n.VectorFromColumn(j)
+			out[i][j], _ = rowVector.DotProduct(columnVector)
+		}
+	}
+	return out
+}
+
+func randomMatrix(rows, columns int, r *rand.Rand) seqoperations.Matrix[int] {
+	m := seqoperations.NewZeroMatrix[int](rows, columns)
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] = r.Intn(21) - 10
+		}
+	}
+	return m
+}
+
+/*
+Test Multiply
+*/
+
+func TestMultiplySmall(t *testing.T) {
+	a := seqoperations.Matrix[int]{{2, 3, 4}, {1, 0, 0}}
+	b := seqoperations.Matrix[int]{{0, 1000}, {1, 100}, {0, 10}}
+	ab, err := a.Multiply(b)
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[int]{{3, 2340}, {0, 1000}}, ab)
+}
+
+func TestMultiplyMatchesNaiveAcrossBlockBoundaries(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for _, shape := range [][3]int{{1, 1, 1}, {63, 64, 65}, {130, 70, 1}, {1, 200, 129}, {100, 100, 100}} {
+		a := randomMatrix(shape[0], shape[1], r)
+		b := randomMatrix(shape[1], shape[2], r)
+		ab, err := a.Multiply(b)
+		assert.Nil(t, err)
+		assert.Equal(t, naiveMultiply(a, b), ab)
+	}
+}
+
+func TestMultiplyEmptyInnerDimension(t *testing.T) {
+	a := seqoperations.Matrix[int]{{}, {}}
+	ab, err := a.Multiply(seqoperations.Matrix[int]{})
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[int]{{}, {}}, ab)
+}
+
+func TestMultiplyInvalid(t *testing.T) {
+	_, err := seqoperations.Matrix[int]{{1, 2}}.Multiply(seqoperations.Matrix[int]{{1, 2}})
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+}
+
+/*
+Test matrix-vector products
+*/
+
+func TestMulVecAndVecMul(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}}
+
+	product, err := m.MulVec(seqoperations.Vector[int]{1, 0, -1})
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[int]{-2, -2}, product)
+	product, err = m.VecMul(seqoperations.Vector[int]{2, -1})
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[int]{-2, -1, 0}, product)
+
+	_, err = m.MulVec(seqoperations.Vector[int]{1, 2})
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+	_, err = m.VecMul(seqoperations.Vector[int]{1, 2, 3})
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+	_, err = seqoperations.Matrix[int]{{1, 2}, {3}}.MulVec(seqoperations.Vector[int]{1, 2})
+	assert.Equal(t, e.ErrRagged, err)
+
+	product, err = seqoperations.Matrix[int]{{}, {}}.MulVec(seqoperations.Vector[int]{})
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[int]{0, 0}, product)
+}
+
+func TestMulVecMatchesMultiply(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	for _, shape := range [][2]int{{1, 1}, {7, 3}, {3, 7}, {90, 130}} {
+		m := randomMatrix(shape[0], shape[1], r)
+		column := randomMatrix(shape[1], 1, r)
+		row := randomMatrix(1, shape[0], r)
+		v, _ := column.VectorFromColumn(0)
+		u, _ := row.VectorFromRow(0)
+
+		expected, _ := m.Multiply(v.AsColumnMatrix())
+		product, err := m.MulVec(v)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, product.AsColumnMatrix())
+
+		expected, _ = u.AsRowMatrix().Multiply(m)
+		product, err = m.VecMul(u)
+		assert.Nil(t, err)
+		assert.Equal(t, expected, product.AsRowMatrix())
+	}
+}
+
+func TestAsRowAndColumnMatrix(t *testing.T) {
+	v := seqoperations.Vector[int]{1, 2, 3}
+	row, column := v.AsRowMatrix(), v.AsColumnMatrix()
+	assert.Equal(t, seqoperations.Matrix[int]{{1, 2, 3}}, row)
+	assert.Equal(t, seqoperations.Matrix[int]{{1}, {2}, {3}}, column)
+	assert.Equal(t, row, column.SequentialTranspose())
+
+	// the matrices hold copies, and appending to a row of the column matrix does not overwrite the next row
+	v[0] = 100
+	column[0] = append(column[0], 7)
+	assert.Equal(t, seqoperations.Matrix[int]{{1, 2, 3}}, row)
+	assert.Equal(t, seqoperations.Matrix[int]{{1, 7}, {2}, {3}}, column)
+
+	i, j := seqoperations.Vector[int]{}.AsColumnMatrix().Dimensions()
+	assert.Equal(t, 0, i)
+	assert.Equal(t, 0, j)
+}
+
+/*
+Benchmark Multiply against the original implementation
+*/
+
+var benchmarkSizes = []int{64, 128, 256, 512, 1024}
+
+func BenchmarkMultiply(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	for _, size := range benchmarkSizes {
+		x, y := randomMatrix(size, size, r).Float64Copy(), randomMatrix(size, size, r).Float64Copy()
+		b.Run(fmt.Sprintf("blocked/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = x.Multiply(y)
+			}
+		})
+		b.Run(fmt.Sprintf("naive/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_ = naiveMultiply(x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkMulVec(b *testing.B) {
+	r := rand.New(rand.NewSource(1))
+	for _, size := range benchmarkSizes {
+		x := randomMatrix(size, size, r).Float64Copy()
+		v, _ := randomMatrix(size, 1, r).Float64Copy().VectorFromColumn(0)
+		b.Run(fmt.Sprintf("mulvec/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = x.MulVec(v)
+			}
+		})
+		b.Run(fmt.Sprintf("multiply/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				_, _ = x.Multiply(v.AsColumnMatrix())
+			}
+		})
+	}
+}
@@ -0,0 +1,202 @@
+package seqoperations
+
+import "math"
+
+// NormL1 returns the sum of the absolute values of the elements of v
+func (v Vector[N]) NormL1() float64 {
+	total := 0.0
+	for _, element := range v {
+		total += math.Abs(float64(element))
+	}
+	return total
+}
+
+// NormL2 returns the Euclidean length of v.
+// Elements are scaled by the largest before squaring so that the result does not overflow or underflow.
+func (v Vector[N]) NormL2() float64 {
+	scale := v.NormInf()
+	if scale == 0.0 || math.IsInf(scale, 1) {
+		return scale
+	}
+	total := 0.0
+	for _, element := range v {
+		x := float64(element) / scale
+		total += x * x
+	}
+	return scale * math.Sqrt(total)
+}
+
+// NormInf returns the largest absolute value of the elements of v, or zero for an empty vector
+func (v Vector[N]) NormInf() float64 {
+	largest := 0.0
+	for _, element := range v {
+		largest = math.Max(largest, math.Abs(float64(element)))
+	}
+	return largest
+}
+
+// NormP returns the p-norm of v, (sum of |v_i|^p)^(1/p), for p >= 1.
+// A p of positive infinity gives NormInf. errNormOrder is returned if p is less than 1.
+func (v Vector[N]) NormP(p float64) (float64, error) {
+	switch {
+	case math.IsNaN(p) || p < 1.0:
+		return 0.0, errNormOrder
+	case p == 1.0:
+		return v.NormL1(), nil
+	case p == 2.0:
+		return v.NormL2(), nil
+	case math.IsInf(p, 1):
+		return v.NormInf(), nil
+	}
+	scale := v.NormInf()
+	if scale == 0.0 || math.IsInf(scale, 1) {
+		return scale, nil
+	}
+	total := 0.0
+	for _, element := range v {
+		total += math.Pow(math.Abs(float64(element))/scale, p)
+	}
+	return scale * math.Pow(total, 1.0/p), nil
+}
+
+// EuclideanDistance returns the Euclidean length of v - u.
+// errDifferentDimension is returned if the vectors are not the same length.
+func (v Vector[N]) EuclideanDistance(u Vector[N]) (float64, error) {
+	difference, err := v.float64Difference(u)
+	if err != nil {
+		return 0.0, err
+	}
+	return difference.NormL2(), nil
+}
+
+// ManhattanDistance returns the sum of the absolute differences between elements of v and u.
+// errDifferentDimension is returned if the vectors are not the same length.
+func (v Vector[N]) ManhattanDistance(u Vector[N]) (float64, error) {
+	difference, err := v.float64Difference(u)
+	if err != nil {
+		return 0.0, err
+	}
+	return difference.NormL1(), nil
+}
+
+// CosineDistance returns 1 - cos(theta), where theta is the angle between v and u, which ranges from
+// 0 for vectors pointing the same way to 2 for vectors pointing in opposite directions.
+// errDifferentDimension is returned if the vectors are not the same length and errZeroNorm if either
+// vector is zero, as its direction is undefined.
+func (v Vector[N]) CosineDistance(u Vector[N]) (float64, error) {
+	difference, _, err := v.unitDistances(u)
+	if err != nil {
+		return 0.0, err
+	}
+	// 1 - cos(theta) is half the squared distance between the unit vectors, which keeps its
+	// precision for small angles where subtracting the cosine from one would not
+	return difference * difference / 2.0, nil
+}
+
+// FrobeniusNorm returns the square root of the sum of the squares of the elements of m.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) FrobeniusNorm() (float64, error) {
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	scale := m.maxAbs()
+	if scale == 0.0 || math.IsInf(scale, 1) {
+		return scale, nil
+	}
+	total := 0.0
+	for _, row := range m {
+		for _, element := range row {
+			x := float64(element) / scale
+			total += x * x
+		}
+	}
+	return scale * math.Sqrt(total), nil
+}
+
+// Norm1 returns the largest sum of the absolute values of the elements of a column of m.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) Norm1() (float64, error) {
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	_, columns := m.Dimensions()
+	sums := make([]float64, columns)
+	for _, row := range m {
+		for j := 0; j < columns; j++ {
+			sums[j] += math.Abs(float64(row[j]))
+		}
+	}
+	largest := 0.0
+	for _, sum := range sums {
+		largest = math.Max(largest, sum)
+	}
+	return largest, nil
+}
+
+// NormInf returns the largest sum of the absolute values of the elements of a row of m.
+// errRagged is returned if the rows of m are not all the same length.
+func (m Matrix[N]) NormInf() (float64, error) {
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	largest := 0.0
+	for _, row := range m {
+		largest = math.Max(largest, Vector[N](row).NormL1())
+	}
+	return largest, nil
+}
+
+// SpectralNorm returns the largest singular value of m, which is the most m can stretch the
+// Euclidean length of a vector. Zero is returned for an empty matrix.
+func (m Matrix[N]) SpectralNorm() (float64, error) {
+	if err := m.Validate(); err != nil {
+		return 0.0, err
+	}
+	rows, columns := m.Dimensions()
+	if rows == 0 || columns == 0 {
+		return 0.0, nil
+	}
+	_, sigma, _, err := m.SVD()
+	if err != nil {
+		return 0.0, err
+	}
+	return sigma[0], nil
+}
+
+// maxAbs returns the largest absolute value of the elements of m, or zero for an empty matrix
+func (m Matrix[N]) maxAbs() float64 {
+	largest := 0.0
+	for _, row := range m {
+		largest = math.Max(largest, Vector[N](row).NormInf())
+	}
+	return largest
+}
+
+// float64Difference returns v - u as float64 values so that unsigned elements do not wrap
+func (v Vector[N]) float64Difference(u Vector[N]) (Vector[float64], error) {
+	if len(v) != len(u) {
+		return Vector[float64]{}, errDifferentDimension
+	}
+	difference := make(Vector[float64], len(v))
+	for i := range v {
+		difference[i] = float64(v[i]) - float64(u[i])
+	}
+	return difference, nil
+}
+
+// unitDistances returns the lengths of the difference and the sum of the unit vectors in the directions of v and u
+func (v Vector[N]) unitDistances(u Vector[N]) (float64, float64, error) {
+	if len(v) != len(u) {
+		return 0.0, 0.0, errDifferentDimension
+	}
+	vNorm, uNorm := v.NormL2(), u.NormL2()
+	if vNorm == 0.0 || uNorm == 0.0 {
+		return 0.0, 0.0, errZeroNorm
+	}
+	difference, sum := make(Vector[float64], len(v)), make(Vector[float64], len(v))
+	for i := range v {
+		vUnit, uUnit := float64(v[i])/vNorm, float64(u[i])/uNorm
+		difference[i], sum[i] = vUnit-uUnit, vUnit+uUnit
+	}
+	return difference.NormL2(), sum.NormL2(), nil
+}
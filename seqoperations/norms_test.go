@@ -0,0 +1,127 @@
+package seqoperations_test
+
+import (
+	"math"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test vector norms
+*/
+
+func TestVectorNorms(t *testing.T) {
+	v := seqoperations.Vector[int]{3, -4, 0, 12}
+	assert.Equal(t, 19.0, v.NormL1())
+	assert.InDelta(t, 13.0, v.NormL2(), 1e-12)
+	assert.Equal(t, 12.0, v.NormInf())
+
+	for p, expected := range map[float64]float64{1: 19, 2: 13, 3: math.Cbrt(27 + 64 + 1728), math.Inf(1): 12} {
+		norm, err := v.NormP(p)
+		assert.Nil(t, err)
+		assert.InDelta(t, expected, norm, 1e-12)
+	}
+	_, err := v.NormP(0.5)
+	assert.Equal(t, e.ErrNormOrder, err)
+	_, err = v.NormP(math.NaN())
+	assert.Equal(t, e.ErrNormOrder, err)
+
+	// scaling keeps the Euclidean norm finite where squaring the elements would overflow
+	huge := seqoperations.Vector[float64]{3e200, 4e200}
+	assert.InDelta(t, 5e200, huge.NormL2(), 1e188)
+	tiny := seqoperations.Vector[float64]{3e-200, 4e-200}
+	assert.InDelta(t, 5e-200, tiny.NormL2(), 1e-212)
+
+	assert.Equal(t, 0.0, seqoperations.Vector[int]{}.NormL2())
+	unsigned := seqoperations.Vector[uint8]{200, 100}
+	assert.Equal(t, 300.0, unsigned.NormL1())
+}
+
+/*
+Test distances
+*/
+
+func TestVectorDistances(t *testing.T) {
+	v := seqoperations.Vector[int]{1, 2, 3}
+	u := seqoperations.Vector[int]{4, 6, 3}
+
+	euclidean, err := v.EuclideanDistance(u)
+	assert.Nil(t, err)
+	assert.InDelta(t, 5.0, euclidean, 1e-12)
+	manhattan, err := v.ManhattanDistance(u)
+	assert.Nil(t, err)
+	assert.Equal(t, 7.0, manhattan)
+
+	cosine, err := v.CosineDistance(v.MultiplyElementsBy(3))
+	assert.Nil(t, err)
+	assert.InDelta(t, 0.0, cosine, 1e-12)
+	cosine, err = v.CosineDistance(v.MultiplyElementsBy(-1))
+	assert.Nil(t, err)
+	assert.InDelta(t, 2.0, cosine, 1e-12)
+	cosine, err = seqoperations.Vector[float64]{1, 0}.CosineDistance(seqoperations.Vector[float64]{0, 5})
+	assert.Nil(t, err)
+	assert.InDelta(t, 1.0, cosine, 1e-12)
+	// 1 - cos(1e-9) is 5e-19, far below the rounding error of one
+	cosine, err = seqoperations.Vector[float64]{1, 0}.CosineDistance(seqoperations.Vector[float64]{1, 1e-9})
+	assert.Nil(t, err)
+	assert.InDelta(t, 5e-19, cosine, 1e-30)
+
+	// unsigned differences are taken in float64 so do not wrap
+	small, large := seqoperations.Vector[uint]{1}, seqoperations.Vector[uint]{4}
+	manhattan, _ = small.ManhattanDistance(large)
+	assert.Equal(t, 3.0, manhattan)
+
+	_, err = v.EuclideanDistance(u[:2])
+	assert.Equal(t, e.ErrDifferentDimension, err)
+	_, err = v.ManhattanDistance(u[:2])
+	assert.Equal(t, e.ErrDifferentDimension, err)
+	_, err = v.CosineDistance(seqoperations.Vector[int]{0, 0, 0})
+	assert.Equal(t, e.ErrZeroNorm, err)
+}
+
+/*
+Test matrix norms
+*/
+
+func TestMatrixNorms(t *testing.T) {
+	m := seqoperations.Matrix[int]{
+		{1, -2, 3},
+		{-4, 5, -6},
+	}
+	frobenius, err := m.FrobeniusNorm()
+	assert.Nil(t, err)
+	assert.InDelta(t, math.Sqrt(91), frobenius, 1e-12)
+	norm1, err := m.Norm1()
+	assert.Nil(t, err)
+	assert.Equal(t, 9.0, norm1)
+	normInf, err := m.NormInf()
+	assert.Nil(t, err)
+	assert.Equal(t, 15.0, normInf)
+
+	spectral, err := m.SpectralNorm()
+	assert.Nil(t, err)
+	// the squared spectral norm is the largest eigenvalue of M * transpose(M) = [[14, -32], [-32, 77]]
+	assert.InDelta(t, math.Sqrt((91+math.Sqrt(63*63+4*32*32))/2), spectral, 1e-10)
+
+	diagonal := seqoperations.Matrix[float64]{{2, 0}, {0, -7}}
+	spectral, err = diagonal.SpectralNorm()
+	assert.Nil(t, err)
+	assert.InDelta(t, 7.0, spectral, 1e-12)
+
+	empty := seqoperations.Matrix[int]{}
+	for _, norm := range []func() (float64, error){empty.FrobeniusNorm, empty.Norm1, empty.NormInf, empty.SpectralNorm} {
+		value, err := norm()
+		assert.Nil(t, err)
+		assert.Equal(t, 0.0, value)
+	}
+
+	for _, ragged := range []seqoperations.Matrix[int]{{{1, 2}, {3}}, {{1}, {2, 3}}} {
+		for _, norm := range []func() (float64, error){ragged.FrobeniusNorm, ragged.Norm1, ragged.NormInf, ragged.SpectralNorm} {
+			_, err = norm()
+			assert.Equal(t, e.ErrRagged, err)
+		}
+	}
+}
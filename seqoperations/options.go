@@ -0,0 +1,79 @@
+package seqoperations
+
+import "github.com/DominicHinton/matrix/internal/textio"
+
+// Default settings used by iterative methods when no IterationOption is supplied
+const (
+	DefaultTolerance     = 1e-12
+	DefaultMaxIterations = 100
+	DefaultRestart       = 30
+)
+
+// iterationSettings holds the stopping criteria of an iterative method
+type iterationSettings struct {
+	tolerance     float64
+	maxIterations int
+	restart       int
+}
+
+// IterationOption adjusts the stopping criteria of an iterative method
+type IterationOption func(*iterationSettings)
+
+// WithTolerance sets the relative tolerance below which an iterative method is considered converged.
+// Values that are not positive are ignored.
+func WithTolerance(tolerance float64) IterationOption {
+	return func(s *iterationSettings) {
+		if tolerance > 0 {
+			s.tolerance = tolerance
+		}
+	}
+}
+
+// WithMaxIterations sets the number of iterations an iterative method may carry out before
+// reporting that it has failed to converge. Values that are not positive are ignored.
+func WithMaxIterations(maxIterations int) IterationOption {
+	return func(s *iterationSettings) {
+		if maxIterations > 0 {
+			s.maxIterations = maxIterations
+		}
+	}
+}
+
+// WithRestart sets the number of iterations GMRES carries out before restarting, which bounds
+// the basis vectors it holds in memory. Values that are not positive are ignored.
+func WithRestart(restart int) IterationOption {
+	return func(s *iterationSettings) {
+		if restart > 0 {
+			s.restart = restart
+		}
+	}
+}
+
+// newIterationSettings returns the default settings with each option applied in turn
+func newIterationSettings(options []IterationOption) iterationSettings {
+	settings := iterationSettings{tolerance: DefaultTolerance, maxIterations: DefaultMaxIterations, restart: DefaultRestart}
+	for _, option := range options {
+		option(&settings)
+	}
+	return settings
+}
+
+// CSVOption adjusts the layout of delimited text read by ReadCSV and written by WriteCSV
+type CSVOption = textio.CSVOption
+
+// WithDelimiter sets the rune separating fields, which is a comma by default.
+// ReadCSV and WriteCSV return errors.ErrInvalidDelimiter for a delimiter that encoding/csv cannot use,
+// such as a quote or newline.
+func WithDelimiter(delimiter rune) CSVOption {
+	return textio.WithDelimiter(delimiter)
+}
+
+// WithHeader makes ReadCSV skip the first record, which holds column names rather than values
+func WithHeader() CSVOption {
+	return textio.WithHeader()
+}
+
+// WithColumnNames makes WriteCSV write names as a header record before the values
+func WithColumnNames(names ...string) CSVOption {
+	return textio.WithColumnNames(names...)
+}
@@ -0,0 +1,153 @@
+package seqoperations
+
+import "math"
+
+// QR returns the QR decomposition of a matrix of any shape computed with Householder reflections.
+// For an i x j matrix M the result satisfies M = Q * R where Q is an i x i orthogonal matrix
+// and R is an i x j upper triangular matrix.
+// An error is returned if the matrix has no elements.
+func (m Matrix[N]) QR() (Matrix[float64], Matrix[float64], error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, Matrix[float64]{}, err
+	}
+	rows, columns := m.Dimensions()
+	if rows == 0 || columns == 0 {
+		return Matrix[float64]{}, Matrix[float64]{}, errZeroLength
+	}
+
+	r := m.float64Copy()
+	q := NewIdentityMatrix[float64](rows)
+	steps := columns
+	if rows-1 < steps {
+		steps = rows - 1
+	}
+	for k := 0; k < steps; k++ {
+		v, ok := householderVector(r, k, k)
+		if !ok {
+			// column is already zero below the diagonal
+			continue
+		}
+		applyHouseholderLeft(r, v, k, k)
+		applyHouseholderRight(q, v, k)
+	}
+
+	// entries below the diagonal are zero up to rounding so are set exactly
+	for i := 0; i < rows; i++ {
+		for j := 0; j < i && j < columns; j++ {
+			r[i][j] = 0.0
+		}
+	}
+	return q, r, nil
+}
+
+// LeastSquares returns the vector x minimising || M * x - b || along with the norm of the
+// residual M * x - b, which is zero when the system is solved exactly.
+// M must have at least as many rows as columns and full column rank.
+// errMultiplicationValidity is returned if the length of b does not match the rows of M,
+// errUnderdetermined if M has more columns than rows and errNoInverse if M is rank deficient.
+func (m Matrix[N]) LeastSquares(b Vector[N]) (Vector[float64], float64, error) {
+	if err := m.Validate(); err != nil {
+		return Vector[float64]{}, 0.0, err
+	}
+	rows, columns := m.Dimensions()
+	if len(b) != rows {
+		return Vector[float64]{}, 0.0, errMultiplicationValidity
+	}
+	if rows < columns {
+		return Vector[float64]{}, 0.0, errUnderdetermined
+	}
+
+	q, r, err := m.QR()
+	if err != nil {
+		return Vector[float64]{}, 0.0, err
+	}
+	if r.hasSmallPivot(singularTolerance(r)) {
+		return Vector[float64]{}, 0.0, errNoInverse
+	}
+
+	// y = transpose(Q) * b
+	y := make([]float64, rows)
+	for i := 0; i < rows; i++ {
+		for k := 0; k < rows; k++ {
+			y[i] += q[k][i] * float64(b[k])
+		}
+	}
+
+	// back substitute with the upper square block of R
+	x := make(Vector[float64], columns)
+	for i := columns - 1; i >= 0; i-- {
+		total := y[i]
+		for j := i + 1; j < columns; j++ {
+			total -= r[i][j] * x[j]
+		}
+		x[i] = total / r[i][i]
+	}
+
+	// the trailing entries of y are the components of b that no choice of x can reach
+	residual := 0.0
+	for i := columns; i < rows; i++ {
+		residual += y[i] * y[i]
+	}
+	return x, math.Sqrt(residual), nil
+}
+
+// householderVector returns the unit vector v defining the reflection I - 2 * v * transpose(v)
+// that zeroes the supplied column of r below the supplied row. v is indexed from that row.
+// false is returned if the column is already zero from that row downwards.
+func householderVector(r Matrix[float64], row, column int) ([]float64, bool) {
+	rows := len(r)
+	v := make([]float64, rows-row)
+	norm := 0.0
+	for i := row; i < rows; i++ {
+		v[i-row] = r[i][column]
+		norm += r[i][column] * r[i][column]
+	}
+	norm = math.Sqrt(norm)
+	if norm == 0.0 {
+		return nil, false
+	}
+
+	// reflect onto the sign opposite to the diagonal entry to avoid cancellation
+	if v[0] > 0 {
+		norm = -norm
+	}
+	v[0] -= norm
+
+	length := 0.0
+	for _, element := range v {
+		length += element * element
+	}
+	length = math.Sqrt(length)
+	for i := range v {
+		v[i] /= length
+	}
+	return v, true
+}
+
+// applyHouseholderLeft replaces r with H * r where H is the reflection defined by v from the supplied row.
+// Columns before the supplied column are known to be unaffected so are skipped.
+func applyHouseholderLeft(r Matrix[float64], v []float64, row, column int) {
+	columns := len(r[0])
+	for j := column; j < columns; j++ {
+		total := 0.0
+		for i := range v {
+			total += v[i] * r[row+i][j]
+		}
+		for i := range v {
+			r[row+i][j] -= 2.0 * v[i] * total
+		}
+	}
+}
+
+// applyHouseholderRight replaces q with q * H where H is the reflection defined by v from column k
+func applyHouseholderRight(q Matrix[float64], v []float64, k int) {
+	for i := range q {
+		total := 0.0
+		for l := range v {
+			total += q[i][k+l] * v[l]
+		}
+		for l := range v {
+			q[i][k+l] -= 2.0 * total * v[l]
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package seqoperations_test
+
+import (
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test QR
+*/
+
+func assertQR[N seqoperations.Number](t *testing.T, m seqoperations.Matrix[N]) {
+	q, r, err := m.QR()
+	assert.Nil(t, err)
+	rows, columns := m.Dimensions()
+
+	qr, err := q.Multiply(r)
+	assert.Nil(t, err)
+	assert.True(t, m.Float64Copy().WithinSigma(qr, 1e-10))
+
+	qtq, err := q.SequentialTranspose().Multiply(q)
+	assert.Nil(t, err)
+	assert.True(t, seqoperations.NewIdentityMatrix[float64](rows).WithinSigma(qtq, 1e-12))
+
+	for i := 0; i < rows; i++ {
+		for j := 0; j < i && j < columns; j++ {
+			assert.Equal(t, 0.0, r[i][j])
+		}
+	}
+}
+
+func TestQRSquare(t *testing.T) {
+	assertQR(t, seqoperations.Matrix[int]{{12, -51, 4}, {6, 167, -68}, {-4, 24, -41}})
+}
+
+func TestQRTall(t *testing.T) {
+	assertQR(t, seqoperations.Matrix[float64]{{1, 2}, {3, 4}, {5, 6}, {7, 8.5}})
+}
+
+func TestQRWide(t *testing.T) {
+	assertQR(t, seqoperations.Matrix[int]{{1, 2, 3, 4}, {0, 5, 6, 7}})
+}
+
+func TestQRZeroColumn(t *testing.T) {
+	assertQR(t, seqoperations.Matrix[int]{{0, 1}, {0, 2}, {0, 3}})
+}
+
+func TestQREmpty(t *testing.T) {
+	_, _, err := seqoperations.Matrix[int]{}.QR()
+	assert.Equal(t, e.ErrZeroLength, err)
+}
+
+/*
+Test LeastSquares
+*/
+
+func TestLeastSquaresExactFit(t *testing.T) {
+	// points on y = 2 + 3x
+	m := seqoperations.Matrix[int]{{1, 0}, {1, 1}, {1, 2}, {1, 3}}
+	x, residual, err := m.LeastSquares(seqoperations.Vector[int]{2, 5, 8, 11})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{2, 3}, []float64(x), 1e-12)
+	assert.InDelta(t, 0.0, residual, 1e-12)
+}
+
+func TestLeastSquaresLineOfBestFit(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{1, 0}, {1, 1}, {1, 2}}
+	x, residual, err := m.LeastSquares(seqoperations.Vector[float64]{6, 0, 0})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{5, -3}, []float64(x), 1e-12)
+	// residuals are 1, -2 and 1
+	assert.InDelta(t, 2.449489742783178, residual, 1e-12)
+}
+
+func TestLeastSquaresErrors(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2}, {2, 4}, {3, 6}}
+	_, _, err := m.LeastSquares(seqoperations.Vector[int]{1, 2, 3})
+	assert.Equal(t, e.ErrNoInverse, err)
+
+	_, _, err = m.LeastSquares(seqoperations.Vector[int]{1, 2})
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+
+	_, _, err = seqoperations.Matrix[int]{{1, 2, 3}}.LeastSquares(seqoperations.Vector[int]{1})
+	assert.Equal(t, e.ErrUnderdetermined, err)
+}
@@ -16,9 +16,18 @@ var (
 	errDifferentDimension      = e.ErrDifferentDimension
 	errMultiplicationValidity  = e.ErrMultiplicationValidity
 	errNonSquare               = e.ErrNonSquare
+	errNoConvergence           = e.ErrNoConvergence
 	errNoInverse               = e.ErrNoInverse
+	errNormOrder               = e.ErrNormOrder
 	errNotFloat64              = e.ErrNotFloat64
+	errNotPositiveDefinite     = e.ErrNotPositiveDefinite
+	errNotSymmetric            = e.ErrNotSymmetric
+	errNotThreeDimensional     = e.ErrNotThreeDimensional
+	errRagged                  = e.ErrRagged
 	errRowColSuppliedOutBounds = e.ErrRowColSuppliedOutBounds
+	errSparseFormat            = e.ErrSparseFormat
+	errUnderdetermined         = e.ErrUnderdetermined
 	errUnexpected              = e.ErrUnexpected
 	errZeroLength              = e.ErrZeroLength
+	errZeroNorm                = e.ErrZeroNorm
 )
@@ -0,0 +1,73 @@
+package seqoperations_test
+
+import (
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test Solve
+*/
+
+func TestSolveThreeByThree(t *testing.T) {
+	m := seqoperations.Matrix[int]{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}}
+	b := seqoperations.Vector[int]{8, -11, -3}
+	x, err := m.Solve(b)
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{2, 3, -1}, []float64(x), 1e-12)
+}
+
+func TestSolveNeedsPivoting(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{0, 1}, {1, 1}}
+	x, err := m.Solve(seqoperations.Vector[float64]{2, 5})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{3, 2}, []float64(x), 1e-12)
+}
+
+func TestSolveErrors(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2}, {2, 4}}
+	_, err := m.Solve(seqoperations.Vector[int]{1, 2})
+	assert.Equal(t, e.ErrNoInverse, err)
+
+	_, err = m.Solve(seqoperations.Vector[int]{1, 2, 3})
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+
+	_, err = seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}}.Solve(seqoperations.Vector[int]{1, 2})
+	assert.Equal(t, e.ErrNonSquare, err)
+}
+
+/*
+Test SolveMatrix
+*/
+
+func TestSolveMatrixMultipleRightHandSides(t *testing.T) {
+	m := seqoperations.Matrix[int]{{4, 3}, {6, 3}}
+	b := seqoperations.Matrix[int]{{10, 1, 7}, {12, 0, 9}}
+	x, err := m.SolveMatrix(b)
+	assert.Nil(t, err)
+
+	product, err := m.Float64Copy().Multiply(x)
+	assert.Nil(t, err)
+	assert.True(t, b.Float64Copy().WithinSigma(product, 1e-12))
+}
+
+func TestSolveMatrixMatchesInverse(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}
+	x, err := m.SolveMatrix(seqoperations.NewIdentityMatrix[int](3))
+	assert.Nil(t, err)
+	inv, err := m.Inverse()
+	assert.Nil(t, err)
+	assert.True(t, inv.WithinSigma(x, 1e-12))
+}
+
+func TestSolveMatrixErrors(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2}, {2, 4}}
+	_, err := m.SolveMatrix(seqoperations.Matrix[int]{{1}, {2}})
+	assert.Equal(t, e.ErrNoInverse, err)
+
+	_, err = m.SolveMatrix(seqoperations.Matrix[int]{{1, 2}})
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+}
@@ -0,0 +1,414 @@
+package seqoperations
+
+import "sort"
+
+// SparseFormat selects how a Sparse matrix is compressed
+type SparseFormat int
+
+const (
+	// CSR compresses by row, so reading a row is cheap
+	CSR SparseFormat = iota
+	// CSC compresses by column, so reading a column is cheap
+	CSC
+)
+
+// Triplet is a single non-zero element of a sparse matrix at (Row, Column)
+type Triplet[N Number] struct {
+	Row, Column int
+	Value       N
+}
+
+// Sparse is a matrix that stores only its non-zero elements, compressed by row (CSR) or by column (CSC).
+// Along the compressed, or major, dimension element k of that row or column is at
+// indices[pointers[k]:pointers[k+1]], which hold the positions along the other dimension in
+// ascending order, with the matching elements in values.
+type Sparse[N Number] struct {
+	format        SparseFormat
+	rows, columns int
+	pointers      []int
+	indices       []int
+	values        []N
+}
+
+// NewSparseFromTriplets returns an i x j Sparse in the supplied format holding the elements of triplets.
+// Triplets at the same position are summed and elements that are zero are not stored.
+// errSparseFormat is returned if format is neither CSR nor CSC
+// and errRowColSuppliedOutBounds if a dimension is negative or a triplet lies outside the matrix.
+func NewSparseFromTriplets[N Number](i, j int, triplets []Triplet[N], format SparseFormat) (Sparse[N], error) {
+	if format != CSR && format != CSC {
+		return Sparse[N]{}, errSparseFormat
+	}
+	if i < 0 || j < 0 {
+		return Sparse[N]{}, errRowColSuppliedOutBounds
+	}
+	for _, t := range triplets {
+		if t.Row < 0 || t.Row >= i || t.Column < 0 || t.Column >= j {
+			return Sparse[N]{}, errRowColSuppliedOutBounds
+		}
+	}
+
+	s := Sparse[N]{format: format, rows: i, columns: j}
+	position := func(t Triplet[N]) (int, int) {
+		if format == CSC {
+			return t.Column, t.Row
+		}
+		return t.Row, t.Column
+	}
+	sorted := make([]Triplet[N], len(triplets))
+	copy(sorted, triplets)
+	sort.SliceStable(sorted, func(a, b int) bool {
+		majorA, minorA := position(sorted[a])
+		majorB, minorB := position(sorted[b])
+		if majorA != majorB {
+			return majorA < majorB
+		}
+		return minorA < minorB
+	})
+
+	majorCount, _ := s.majorMinor()
+	s.pointers = make([]int, majorCount+1)
+	for k := 0; k < len(sorted); {
+		major, minor := position(sorted[k])
+		total := sorted[k].Value
+		// sum every triplet at the same position
+		for k++; k < len(sorted); k++ {
+			nextMajor, nextMinor := position(sorted[k])
+			if nextMajor != major || nextMinor != minor {
+				break
+			}
+			total += sorted[k].Value
+		}
+		if total != 0 {
+			s.indices = append(s.indices, minor)
+			s.values = append(s.values, total)
+			s.pointers[major+1]++
+		}
+	}
+	for k := 0; k < majorCount; k++ {
+		s.pointers[k+1] += s.pointers[k]
+	}
+	return s, nil
+}
+
+// Sparse returns the non-zero elements of the matrix in a Sparse of the supplied format.
+// errRagged is returned if the rows of m are not all the same length
+// and errSparseFormat if format is neither CSR nor CSC.
+func (m Matrix[N]) Sparse(format SparseFormat) (Sparse[N], error) {
+	if err := m.Validate(); err != nil {
+		return Sparse[N]{}, err
+	}
+	rows, columns := m.Dimensions()
+	triplets := []Triplet[N]{}
+	for i := 0; i < rows; i++ {
+		for j := 0; j < columns; j++ {
+			if m[i][j] != 0 {
+				triplets = append(triplets, Triplet[N]{i, j, m[i][j]})
+			}
+		}
+	}
+	return NewSparseFromTriplets(rows, columns, triplets, format)
+}
+
+// Matrix returns s as a Matrix with every element stored
+func (s Sparse[N]) Matrix() Matrix[N] {
+	m := NewZeroMatrix[N](s.rows, s.columns)
+	s.forEach(func(i, j int, value N) {
+		m[i][j] = value
+	})
+	return m
+}
+
+// Dimensions returns the number of rows and columns of s
+func (s Sparse[N]) Dimensions() (int, int) {
+	return s.rows, s.columns
+}
+
+// Format returns whether s is compressed by row or by column
+func (s Sparse[N]) Format() SparseFormat {
+	return s.format
+}
+
+// NonZero returns the number of elements stored in s
+func (s Sparse[N]) NonZero() int {
+	return len(s.values)
+}
+
+// At returns element (i, j) of s, found by binary search within its row or column.
+// At panics if i or j is out of range.
+func (s Sparse[N]) At(i, j int) N {
+	if i < 0 || i >= s.rows || j < 0 || j >= s.columns {
+		panic(errRowColSuppliedOutBounds)
+	}
+	if s.format == CSC {
+		return s.element(j, i)
+	}
+	return s.element(i, j)
+}
+
+// ToCSR returns s compressed by row. s is returned unchanged if it is already CSR.
+func (s Sparse[N]) ToCSR() Sparse[N] {
+	if s.format == CSR {
+		return s
+	}
+	return s.convert()
+}
+
+// ToCSC returns s compressed by column. s is returned unchanged if it is already CSC.
+func (s Sparse[N]) ToCSC() Sparse[N] {
+	if s.format == CSC {
+		return s
+	}
+	return s.convert()
+}
+
+// Transpose returns the transpose of s without copying.
+// The CSR form of a matrix is the CSC form of its transpose, so the result shares storage with s
+// and is in the other format.
+func (s Sparse[N]) Transpose() Sparse[N] {
+	format := CSC
+	if s.format == CSC {
+		format = CSR
+	}
+	return Sparse[N]{
+		format:   format,
+		rows:     s.columns,
+		columns:  s.rows,
+		pointers: s.pointers,
+		indices:  s.indices,
+		values:   s.values,
+	}
+}
+
+// VectorFromRow returns a dense vector holding the row of s if it exists, otherwise false.
+// A row of a CSC matrix is gathered by searching each column for it, without converting s.
+func (s Sparse[N]) VectorFromRow(row int) (Vector[N], bool) {
+	if row < 0 || row >= s.rows {
+		return Vector[N]{}, false
+	}
+	if s.format == CSC {
+		return s.minorVector(row), true
+	}
+	return s.majorVector(row), true
+}
+
+// VectorFromColumn returns a dense vector holding the column of s if it exists, otherwise false.
+// A column of a CSR matrix is gathered by searching each row for it, without converting s.
+func (s Sparse[N]) VectorFromColumn(column int) (Vector[N], bool) {
+	if column < 0 || column >= s.columns {
+		return Vector[N]{}, false
+	}
+	if s.format == CSR {
+		return s.minorVector(column), true
+	}
+	return s.majorVector(column), true
+}
+
+// MultiplyVector returns the vector s * v.
+// errMultiplicationValidity is returned if the length of v does not match the columns of s.
+func (s Sparse[N]) MultiplyVector(v Vector[N]) (Vector[N], error) {
+	if len(v) != s.columns {
+		return Vector[N]{}, errMultiplicationValidity
+	}
+	out := NewZeroVector[N](s.rows)
+	s.forEach(func(i, j int, value N) {
+		out[i] += value * v[j]
+	})
+	return out, nil
+}
+
+// MultiplyDense returns the matrix P = S * M, with every element of P stored.
+// Each stored element of S scales a row of M into a row of P, so only the non-zero elements are visited.
+// errMultiplicationValidity is returned if the rows of M do not match the columns of S.
+func (s Sparse[N]) MultiplyDense(m Matrix[N]) (Matrix[N], error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[N]{}, err
+	}
+	rows, columns := m.Dimensions()
+	if rows != s.columns {
+		return Matrix[N]{}, errMultiplicationValidity
+	}
+	out := NewZeroMatrix[N](s.rows, columns)
+	s.forEach(func(i, k int, value N) {
+		outRow, mRow := out[i], m[k]
+		for j := 0; j < columns; j++ {
+			outRow[j] += value * mRow[j]
+		}
+	})
+	return out, nil
+}
+
+// Multiply returns the sparse product P = S * T in the format of S.
+// Rows of P are accumulated one at a time from rows of T (Gustavson's algorithm),
+// so the work done is proportional to the number of multiplications between stored elements.
+// errMultiplicationValidity is returned if the rows of T do not match the columns of S.
+func (s Sparse[N]) Multiply(t Sparse[N]) (Sparse[N], error) {
+	if s.columns != t.rows {
+		return Sparse[N]{}, errMultiplicationValidity
+	}
+	a, b := s.ToCSR(), t.ToCSR()
+
+	p := Sparse[N]{format: CSR, rows: a.rows, columns: b.columns, pointers: make([]int, a.rows+1)}
+	accumulator := make([]N, b.columns)
+	// occupied marks the columns touched in the current row, tagged with the row number + 1
+	occupied := make([]int, b.columns)
+	touched := []int{}
+	for i := 0; i < a.rows; i++ {
+		touched = touched[:0]
+		for ka := a.pointers[i]; ka < a.pointers[i+1]; ka++ {
+			k, scale := a.indices[ka], a.values[ka]
+			for kb := b.pointers[k]; kb < b.pointers[k+1]; kb++ {
+				j := b.indices[kb]
+				if occupied[j] != i+1 {
+					occupied[j] = i + 1
+					accumulator[j] = 0
+					touched = append(touched, j)
+				}
+				accumulator[j] += scale * b.values[kb]
+			}
+		}
+		sort.Ints(touched)
+		for _, j := range touched {
+			if accumulator[j] != 0 {
+				p.indices = append(p.indices, j)
+				p.values = append(p.values, accumulator[j])
+			}
+		}
+		p.pointers[i+1] = len(p.values)
+	}
+
+	if s.format == CSC {
+		return p.ToCSC(), nil
+	}
+	return p, nil
+}
+
+// Add returns the sparse sum S + T in the format of S.
+// Elements that cancel to zero are not stored.
+// errDifferentDimension is returned if S and T are not the same dimensions.
+func (s Sparse[N]) Add(t Sparse[N]) (Sparse[N], error) {
+	if s.rows != t.rows || s.columns != t.columns {
+		return Sparse[N]{}, errDifferentDimension
+	}
+	if t.format != s.format {
+		t = t.convert()
+	}
+
+	majorCount, _ := s.majorMinor()
+	out := Sparse[N]{format: s.format, rows: s.rows, columns: s.columns, pointers: make([]int, majorCount+1)}
+	appendValue := func(minor int, value N) {
+		if value != 0 {
+			out.indices = append(out.indices, minor)
+			out.values = append(out.values, value)
+		}
+	}
+	for major := 0; major < majorCount; major++ {
+		// merge the two sorted runs of indices
+		ks, ks1 := s.pointers[major], s.pointers[major+1]
+		kt, kt1 := t.pointers[major], t.pointers[major+1]
+		for ks < ks1 || kt < kt1 {
+			switch {
+			case kt == kt1 || (ks < ks1 && s.indices[ks] < t.indices[kt]):
+				appendValue(s.indices[ks], s.values[ks])
+				ks++
+			case ks == ks1 || t.indices[kt] < s.indices[ks]:
+				appendValue(t.indices[kt], t.values[kt])
+				kt++
+			default:
+				appendValue(s.indices[ks], s.values[ks]+t.values[kt])
+				ks++
+				kt++
+			}
+		}
+		out.pointers[major+1] = len(out.values)
+	}
+	return out, nil
+}
+
+// majorMinor returns the lengths of the compressed and uncompressed dimensions of s
+func (s Sparse[N]) majorMinor() (int, int) {
+	if s.format == CSC {
+		return s.columns, s.rows
+	}
+	return s.rows, s.columns
+}
+
+// majorVector returns row or column major of s, as selected by its format, as a dense vector
+func (s Sparse[N]) majorVector(major int) Vector[N] {
+	_, minorCount := s.majorMinor()
+	v := NewZeroVector[N](minorCount)
+	for k := s.pointers[major]; k < s.pointers[major+1]; k++ {
+		v[s.indices[k]] = s.values[k]
+	}
+	return v
+}
+
+// minorVector returns the dense vector of the elements at position minor along the uncompressed
+// dimension of s, that is a column of a CSR matrix or a row of a CSC matrix
+func (s Sparse[N]) minorVector(minor int) Vector[N] {
+	majorCount, _ := s.majorMinor()
+	v := NewZeroVector[N](majorCount)
+	for major := 0; major < majorCount; major++ {
+		v[major] = s.element(major, minor)
+	}
+	return v
+}
+
+// element returns the element at position minor of row or column major of s, as selected by its format,
+// found by binary search of the sorted indices of that row or column
+func (s Sparse[N]) element(major, minor int) N {
+	start, end := s.pointers[major], s.pointers[major+1]
+	k := start + sort.SearchInts(s.indices[start:end], minor)
+	if k < end && s.indices[k] == minor {
+		return s.values[k]
+	}
+	return N(0)
+}
+
+// forEach calls fn with the row, column and value of every stored element of s
+func (s Sparse[N]) forEach(fn func(i, j int, value N)) {
+	majorCount, _ := s.majorMinor()
+	for major := 0; major < majorCount; major++ {
+		for k := s.pointers[major]; k < s.pointers[major+1]; k++ {
+			if s.format == CSC {
+				fn(s.indices[k], major, s.values[k])
+			} else {
+				fn(major, s.indices[k], s.values[k])
+			}
+		}
+	}
+}
+
+// convert returns s in the other format. Elements are scattered by their uncompressed index while
+// walking the compressed dimension in order, so every run of indices in the result is already sorted.
+func (s Sparse[N]) convert() Sparse[N] {
+	majorCount, minorCount := s.majorMinor()
+	format := CSC
+	if s.format == CSC {
+		format = CSR
+	}
+	out := Sparse[N]{
+		format:   format,
+		rows:     s.rows,
+		columns:  s.columns,
+		pointers: make([]int, minorCount+1),
+		indices:  make([]int, len(s.values)),
+		values:   make([]N, len(s.values)),
+	}
+	for _, minor := range s.indices {
+		out.pointers[minor+1]++
+	}
+	for k := 0; k < minorCount; k++ {
+		out.pointers[k+1] += out.pointers[k]
+	}
+	next := make([]int, minorCount)
+	copy(next, out.pointers[:minorCount])
+	for major := 0; major < majorCount; major++ {
+		for k := s.pointers[major]; k < s.pointers[major+1]; k++ {
+			minor := s.indices[k]
+			out.indices[next[minor]] = major
+			out.values[next[minor]] = s.values[k]
+			next[minor]++
+		}
+	}
+	return out
+}
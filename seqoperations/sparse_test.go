@@ -0,0 +1,170 @@
+package seqoperations_test
+
+import (
+	"math/rand"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+// randomSparseMatrix returns a matrix in which roughly fill of the elements are non-zero
+func randomSparseMatrix(rows, columns int, fill float64, r *rand.Rand) seqoperations.Matrix[int] {
+	m := seqoperations.NewZeroMatrix[int](rows, columns)
+	for i := range m {
+		for j := range m[i] {
+			if r.Float64() < fill {
+				m[i][j] = r.Intn(19) - 9
+			}
+		}
+	}
+	return m
+}
+
+var sparseFormats = []seqoperations.SparseFormat{seqoperations.CSR, seqoperations.CSC}
+
+/*
+Test Sparse construction
+*/
+
+func TestNewSparseFromTriplets(t *testing.T) {
+	triplets := []seqoperations.Triplet[int]{
+		{Row: 2, Column: 1, Value: 5},
+		{Row: 0, Column: 3, Value: 1},
+		{Row: 2, Column: 1, Value: 2},
+		{Row: 1, Column: 0, Value: 4},
+		{Row: 1, Column: 2, Value: 3},
+		{Row: 1, Column: 2, Value: -3},
+	}
+	expected := seqoperations.Matrix[int]{
+		{0, 0, 0, 1},
+		{4, 0, 0, 0},
+		{0, 7, 0, 0},
+	}
+	for _, format := range sparseFormats {
+		s, err := seqoperations.NewSparseFromTriplets(3, 4, triplets, format)
+		assert.Nil(t, err)
+		assert.Equal(t, format, s.Format())
+		// duplicates are summed and cancelled elements are not stored
+		assert.Equal(t, 3, s.NonZero())
+		assert.Equal(t, expected, s.Matrix())
+		assert.Equal(t, 7, s.At(2, 1))
+		assert.Equal(t, 0, s.At(1, 2))
+		rows, columns := s.Dimensions()
+		assert.Equal(t, 3, rows)
+		assert.Equal(t, 4, columns)
+	}
+
+	_, err := seqoperations.NewSparseFromTriplets(2, 2, []seqoperations.Triplet[int]{{Row: 2, Column: 0, Value: 1}}, seqoperations.CSR)
+	assert.Equal(t, e.ErrRowColSuppliedOutBounds, err)
+	_, err = seqoperations.NewSparseFromTriplets[int](-1, 2, nil, seqoperations.CSR)
+	assert.Equal(t, e.ErrRowColSuppliedOutBounds, err)
+	for _, format := range []seqoperations.SparseFormat{-1, 2} {
+		_, err = seqoperations.NewSparseFromTriplets[int](2, 2, nil, format)
+		assert.Equal(t, e.ErrSparseFormat, err)
+		_, err = seqoperations.Matrix[int]{{1, 0}, {0, 1}}.Sparse(format)
+		assert.Equal(t, e.ErrSparseFormat, err)
+	}
+
+	empty, err := seqoperations.NewSparseFromTriplets[int](0, 0, nil, seqoperations.CSC)
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Matrix[int]{}, empty.Matrix())
+}
+
+func TestSparseConversions(t *testing.T) {
+	r := rand.New(rand.NewSource(17))
+	m := randomSparseMatrix(30, 45, 0.1, r)
+	for _, format := range sparseFormats {
+		s, err := m.Sparse(format)
+		assert.Nil(t, err)
+		assert.Equal(t, m, s.Matrix())
+		assert.Equal(t, m, s.ToCSR().Matrix())
+		assert.Equal(t, m, s.ToCSC().Matrix())
+		assert.Equal(t, seqoperations.CSR, s.ToCSR().Format())
+		assert.Equal(t, seqoperations.CSC, s.ToCSC().Format())
+		assert.Equal(t, m.SequentialTranspose(), s.Transpose().Matrix())
+
+		for i := 0; i < 30; i++ {
+			row, ok := s.VectorFromRow(i)
+			assert.True(t, ok)
+			expected, _ := m.VectorFromRow(i)
+			assert.Equal(t, expected, row)
+		}
+		for j := 0; j < 45; j++ {
+			column, ok := s.VectorFromColumn(j)
+			assert.True(t, ok)
+			expected, _ := m.VectorFromColumn(j)
+			assert.Equal(t, expected, column)
+		}
+		_, ok := s.VectorFromRow(30)
+		assert.False(t, ok)
+		_, ok = s.VectorFromColumn(-1)
+		assert.False(t, ok)
+		assert.Panics(t, func() { s.At(30, 0) })
+	}
+
+	_, err := seqoperations.Matrix[int]{{1, 2}, {3}}.Sparse(seqoperations.CSR)
+	assert.Equal(t, e.ErrRagged, err)
+}
+
+/*
+Test Sparse arithmetic agrees with dense arithmetic
+*/
+
+func TestSparseMultiplyAndAdd(t *testing.T) {
+	r := rand.New(rand.NewSource(29))
+	a := randomSparseMatrix(40, 25, 0.15, r)
+	b := randomSparseMatrix(25, 35, 0.15, r)
+	c := randomSparseMatrix(40, 25, 0.15, r)
+	product, _ := a.Multiply(b)
+	sum, _ := a.AddMatrices(c)
+	dense := randomMatrix(25, 6, r)
+	denseProduct, _ := a.Multiply(dense)
+	v := seqoperations.Vector[int](randomMatrix(1, 25, r)[0])
+
+	for _, aFormat := range sparseFormats {
+		aSparse, _ := a.Sparse(aFormat)
+
+		got, err := aSparse.MultiplyDense(dense)
+		assert.Nil(t, err)
+		assert.Equal(t, denseProduct, got)
+
+		gotVector, err := aSparse.MultiplyVector(v)
+		assert.Nil(t, err)
+		for i := range gotVector {
+			rowVector, _ := a.VectorFromRow(i)
+			dot, _ := rowVector.DotProduct(v)
+			assert.Equal(t, dot, gotVector[i])
+		}
+
+		for _, bFormat := range sparseFormats {
+			bSparse, _ := b.Sparse(bFormat)
+			p, err := aSparse.Multiply(bSparse)
+			assert.Nil(t, err)
+			assert.Equal(t, aFormat, p.Format())
+			assert.Equal(t, product, p.Matrix())
+
+			cSparse, _ := c.Sparse(bFormat)
+			s, err := aSparse.Add(cSparse)
+			assert.Nil(t, err)
+			assert.Equal(t, aFormat, s.Format())
+			assert.Equal(t, sum, s.Matrix())
+		}
+
+		// elements that cancel are dropped
+		negated, _ := a.MultiplyElementsBy(-1).Sparse(aFormat)
+		zero, err := aSparse.Add(negated)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, zero.NonZero())
+
+		_, err = aSparse.Multiply(aSparse)
+		assert.Equal(t, e.ErrMultiplicationValidity, err)
+		_, err = aSparse.MultiplyDense(dense[:3])
+		assert.Equal(t, e.ErrMultiplicationValidity, err)
+		_, err = aSparse.MultiplyVector(v[:3])
+		assert.Equal(t, e.ErrMultiplicationValidity, err)
+		_, err = aSparse.Add(aSparse.Transpose())
+		assert.Equal(t, e.ErrDifferentDimension, err)
+	}
+}
@@ -0,0 +1,77 @@
+package seqoperations_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test String and Format
+*/
+
+func TestMatrixString(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{1, -22.5, 3}, {400, 5, 6.125}}
+	expected := "[  1 -22.5     3]\n" +
+		"[400     5 6.125]"
+	assert.Equal(t, expected, m.String())
+	assert.Equal(t, expected, fmt.Sprint(m))
+
+	assert.Equal(t, "[]", seqoperations.Matrix[int]{}.String())
+	assert.Equal(t, "[]\n[]", seqoperations.Matrix[int]{{}, {}}.String())
+	assert.Equal(t, "[1 2]\n[3  ]", seqoperations.Matrix[int]{{1, 2}, {3}}.String())
+
+	// large integers are printed in full rather than converted
+	assert.Equal(t, "[9007199254740993]", seqoperations.Matrix[int64]{{9007199254740993}}.String())
+}
+
+func TestMatrixFormat(t *testing.T) {
+	m := seqoperations.Matrix[float64]{{1, -22.5, 3}, {400, 5, 6.125}}
+
+	assert.Equal(t, "[  1.000 -22.500 3.000]\n[400.000   5.000 6.125]", fmt.Sprintf("%.3f", m))
+	assert.Equal(t, "Matrix[float64] 2x3:\n[  1 -22.5     3]\n[400     5 6.125]", fmt.Sprintf("%+v", m))
+	assert.Equal(t, "[1.0    -22.5  3.0   ]\n[400.0  5.0    6.1   ]", fmt.Sprintf("%-6.1f", m))
+	assert.Equal(t, "[][]float64{[]float64{1, -22.5, 3}, []float64{400, 5, 6.125}}", fmt.Sprintf("%#v", m))
+	assert.Equal(t, "[+1.0 ... +3.0]", fmt.Sprintf("%+.1f", m[:1].Truncated(0, 2)))
+
+	integers := seqoperations.Matrix[int]{{255, 16}, {1, 0}}
+	assert.Equal(t, "[ff 10]\n[ 1  0]", fmt.Sprintf("%x", integers))
+	assert.Equal(t, "[0255 0016]\n[0001 0000]", fmt.Sprintf("%04d", integers))
+}
+
+func TestMatrixTruncated(t *testing.T) {
+	m := seqoperations.NewZeroMatrix[int](6, 6)
+	for i := range m {
+		for j := range m[i] {
+			m[i][j] = 10*i + j
+		}
+	}
+	expected := "[ 0  1 ...  5]\n" +
+		"[10 11 ... 15]\n" +
+		"...\n" +
+		"[50 51 ... 55]"
+	assert.Equal(t, expected, m.Truncated(3, 3).String())
+	assert.Equal(t, "[ 0 ...  5]\n[10 ... 15]\n[20 ... 25]\n[30 ... 35]\n[40 ... 45]\n[50 ... 55]", m.Truncated(0, 2).String())
+	assert.Equal(t, m.String(), m.Truncated(6, 6).String())
+	assert.Equal(t, "Matrix[int] 6x6:\n[0 ... 5]\n...", fmt.Sprintf("%+v", m.Truncated(1, 2)))
+}
+
+func TestVectorFormat(t *testing.T) {
+	v := seqoperations.Vector[int]{1, 2, 3, 4, 5, 6, 7}
+	assert.Equal(t, "[1 2 3 4 5 6 7]", v.String())
+	assert.Equal(t, "[1 2 3 4 5 6 7]", fmt.Sprint(v))
+	assert.Equal(t, "Vector[int] 7: [1 2 3 4 5 6 7]", fmt.Sprintf("%+v", v))
+	assert.Equal(t, "[01 02 03 04 05 06 07]", fmt.Sprintf("%02d", v))
+	assert.Equal(t, "[]int{1, 2, 3, 4, 5, 6, 7}", fmt.Sprintf("%#v", v))
+	assert.Equal(t, "[]", seqoperations.Vector[float64]{}.String())
+
+	assert.Equal(t, "[1 2 ... 6 7]", v.Truncated(4).String())
+	assert.Equal(t, "[1 2 3 ... 6 7]", v.Truncated(5).String())
+	assert.Equal(t, "[1 ...]", v.Truncated(1).String())
+	assert.Equal(t, v.String(), v.Truncated(0).String())
+
+	f := seqoperations.Vector[float32]{0.5, 1.0 / 3.0}
+	assert.Equal(t, "[0.50 0.33]", fmt.Sprintf("%.2f", f))
+}
@@ -0,0 +1,221 @@
+package seqoperations
+
+import (
+	"math"
+	"sort"
+)
+
+// SVD returns the thin singular value decomposition M = U * diag(sigma) * Vt of an i x j matrix,
+// found with the one sided Jacobi method. With k = min(i, j), U is i x k with orthonormal columns,
+// sigma holds the k singular values in descending order and Vt is k x j with orthonormal rows.
+// Each iteration is one sweep of rotations over every pair of columns and the stopping criteria
+// may be adjusted with WithTolerance and WithMaxIterations.
+// errNoConvergence is returned if the iteration limit is reached.
+func (m Matrix[N]) SVD(options ...IterationOption) (Matrix[float64], Vector[float64], Matrix[float64], error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[float64]{}, Vector[float64]{}, Matrix[float64]{}, err
+	}
+	rows, columns := m.Dimensions()
+	if rows == 0 || columns == 0 {
+		return Matrix[float64]{}, Vector[float64]{}, Matrix[float64]{}, errZeroLength
+	}
+	settings := newIterationSettings(options)
+
+	// one sided Jacobi orthogonalises columns so a wide matrix is decomposed through its transpose
+	if rows < columns {
+		u, sigma, vt, err := m.float64Copy().transpose().SVD(options...)
+		if err != nil {
+			return Matrix[float64]{}, Vector[float64]{}, Matrix[float64]{}, err
+		}
+		return vt.transpose(), sigma, u.transpose(), nil
+	}
+
+	u := m.float64Copy()
+	v := NewIdentityMatrix[float64](columns)
+	// columns of a rank deficient matrix shrink towards rounding noise which never satisfies a
+	// purely relative test, so products this small compared to the matrix are also accepted
+	negligible := machineEpsilon * machineEpsilon * math.Pow(frobenius(u), 2)
+	converged := false
+	for sweep := 0; sweep < settings.maxIterations && !converged; sweep++ {
+		converged = true
+		for p := 0; p < columns-1; p++ {
+			for q := p + 1; q < columns; q++ {
+				alpha, beta, gamma := 0.0, 0.0, 0.0
+				for i := 0; i < rows; i++ {
+					alpha += u[i][p] * u[i][p]
+					beta += u[i][q] * u[i][q]
+					gamma += u[i][p] * u[i][q]
+				}
+				if math.Abs(gamma) <= settings.tolerance*math.Sqrt(alpha*beta) || math.Abs(gamma) <= negligible {
+					continue
+				}
+				converged = false
+
+				zeta := (beta - alpha) / (2.0 * gamma)
+				t := 1.0 / (math.Abs(zeta) + math.Sqrt(1.0+zeta*zeta))
+				if zeta < 0 {
+					t = -t
+				}
+				c := 1.0 / math.Sqrt(1.0+t*t)
+				s := c * t
+				rotateColumns(u, p, q, c, s)
+				rotateColumns(v, p, q, c, s)
+			}
+		}
+	}
+	if !converged {
+		return Matrix[float64]{}, Vector[float64]{}, Matrix[float64]{}, errNoConvergence
+	}
+
+	// singular values are the column norms, ordered descending with their vectors
+	norms := make([]float64, columns)
+	for j := 0; j < columns; j++ {
+		for i := 0; i < rows; i++ {
+			norms[j] += u[i][j] * u[i][j]
+		}
+		norms[j] = math.Sqrt(norms[j])
+	}
+	order := make([]int, columns)
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return norms[order[i]] > norms[order[j]] })
+
+	uOut := NewZeroMatrix[float64](rows, columns)
+	sigma := make(Vector[float64], columns)
+	vt := NewZeroMatrix[float64](columns, columns)
+	tolerance := m.defaultSingularValueTolerance(Vector[float64]{norms[order[0]]})
+	for j, k := range order {
+		sigma[j] = norms[k]
+		if norms[k] > tolerance {
+			for i := 0; i < rows; i++ {
+				uOut[i][j] = u[i][k] / norms[k]
+			}
+		} else {
+			// the column is rounding noise so is replaced with a unit vector orthogonal to those before it
+			completeOrthonormalColumn(uOut, j)
+		}
+		for i := 0; i < columns; i++ {
+			vt[j][i] = v[i][k]
+		}
+	}
+	return uOut, sigma, vt, nil
+}
+
+// Rank returns the number of singular values of the matrix larger than tolerance.
+// If tolerance is not positive, max(i, j) * largest singular value * machine epsilon is used.
+func (m Matrix[N]) Rank(tolerance float64) (int, error) {
+	_, sigma, _, err := m.SVD()
+	if err != nil {
+		return 0, err
+	}
+	if tolerance <= 0 {
+		tolerance = m.defaultSingularValueTolerance(sigma)
+	}
+	rank := 0
+	for _, value := range sigma {
+		if value > tolerance {
+			rank++
+		}
+	}
+	return rank, nil
+}
+
+// PseudoInverse returns the Moore-Penrose pseudo-inverse of a matrix of any shape, which equals
+// the inverse for square non-singular input. Singular values no larger than
+// max(i, j) * largest singular value * machine epsilon are treated as zero.
+func (m Matrix[N]) PseudoInverse() (Matrix[float64], error) {
+	u, sigma, vt, err := m.SVD()
+	if err != nil {
+		return Matrix[float64]{}, err
+	}
+	tolerance := m.defaultSingularValueTolerance(sigma)
+
+	// pseudo-inverse = transpose(Vt) * diag(1 / sigma) * transpose(U)
+	rows, columns := m.Dimensions()
+	out := NewZeroMatrix[float64](columns, rows)
+	for k, value := range sigma {
+		if value <= tolerance {
+			continue
+		}
+		for i := 0; i < columns; i++ {
+			scaled := vt[k][i] / value
+			for j := 0; j < rows; j++ {
+				out[i][j] += scaled * u[j][k]
+			}
+		}
+	}
+	return out, nil
+}
+
+// ConditionNumber returns the ratio of the largest to smallest singular value of the matrix.
+// Large values indicate that results from Inverse and Solve may be inaccurate.
+// Positive infinity is returned if the smallest singular value is zero.
+func (m Matrix[N]) ConditionNumber() (float64, error) {
+	_, sigma, _, err := m.SVD()
+	if err != nil {
+		return 0.0, err
+	}
+	smallest := sigma[len(sigma)-1]
+	if smallest == 0.0 {
+		return math.Inf(1), nil
+	}
+	return sigma[0] / smallest, nil
+}
+
+// defaultSingularValueTolerance returns the size below which singular values of m are treated as zero
+func (m Matrix[N]) defaultSingularValueTolerance(sigma Vector[float64]) float64 {
+	rows, columns := m.Dimensions()
+	if columns > rows {
+		rows = columns
+	}
+	return float64(rows) * sigma[0] * machineEpsilon
+}
+
+// rotateColumns applies a plane rotation to columns p and q of a in place
+func rotateColumns(a Matrix[float64], p, q int, c, s float64) {
+	for i := range a {
+		ap, aq := a[i][p], a[i][q]
+		a[i][p] = c*ap - s*aq
+		a[i][q] = s*ap + c*aq
+	}
+}
+
+// completeOrthonormalColumn sets column j of u to a unit vector orthogonal to columns 0 to j-1,
+// which must already be orthonormal, by orthogonalising each standard basis vector in turn
+func completeOrthonormalColumn(u Matrix[float64], j int) {
+	rows := len(u)
+	candidate := make([]float64, rows)
+	for basis := 0; basis < rows; basis++ {
+		for i := range candidate {
+			candidate[i] = 0.0
+		}
+		candidate[basis] = 1.0
+
+		// two passes of Gram-Schmidt keep the result orthogonal in floating point
+		for pass := 0; pass < 2; pass++ {
+			for k := 0; k < j; k++ {
+				projection := 0.0
+				for i := 0; i < rows; i++ {
+					projection += u[i][k] * candidate[i]
+				}
+				for i := 0; i < rows; i++ {
+					candidate[i] -= projection * u[i][k]
+				}
+			}
+		}
+
+		norm := 0.0
+		for _, element := range candidate {
+			norm += element * element
+		}
+		norm = math.Sqrt(norm)
+		// a candidate mostly inside the existing columns would lose accuracy when normalised
+		if norm > 0.5 {
+			for i := 0; i < rows; i++ {
+				u[i][j] = candidate[i] / norm
+			}
+			return
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package seqoperations_test
+
+import (
+	"math"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test SVD
+*/
+
+func assertSVD[N seqoperations.Number](t *testing.T, m seqoperations.Matrix[N]) seqoperations.Vector[float64] {
+	u, sigma, vt, err := m.SVD()
+	assert.Nil(t, err)
+	rows, columns := m.Dimensions()
+	k := rows
+	if columns < k {
+		k = columns
+	}
+	assert.Equal(t, k, len(sigma))
+	for i := 1; i < len(sigma); i++ {
+		assert.GreaterOrEqual(t, sigma[i-1], sigma[i])
+	}
+
+	us := u.Copy()
+	for i := range us {
+		for j := range us[i] {
+			us[i][j] *= sigma[j]
+		}
+	}
+	usvt, err := us.Multiply(vt)
+	assert.Nil(t, err)
+	assert.True(t, m.Float64Copy().WithinSigma(usvt, 1e-10))
+
+	utu, err := u.SequentialTranspose().Multiply(u)
+	assert.Nil(t, err)
+	assert.True(t, seqoperations.NewIdentityMatrix[float64](k).WithinSigma(utu, 1e-12))
+
+	vvt, err := vt.Multiply(vt.SequentialTranspose())
+	assert.Nil(t, err)
+	assert.True(t, seqoperations.NewIdentityMatrix[float64](k).WithinSigma(vvt, 1e-12))
+	return sigma
+}
+
+func TestSVDSquare(t *testing.T) {
+	sigma := assertSVD(t, seqoperations.Matrix[int]{{3, 0}, {4, 5}})
+	assert.InDeltaSlice(t, []float64{math.Sqrt(45), math.Sqrt(5)}, []float64(sigma), 1e-12)
+}
+
+func TestSVDTall(t *testing.T) {
+	assertSVD(t, seqoperations.Matrix[float64]{{1, 2}, {3, 4}, {5, 6}, {7, 8}})
+}
+
+func TestSVDWide(t *testing.T) {
+	sigma := assertSVD(t, seqoperations.Matrix[int]{{3, 2, 2}, {2, 3, -2}})
+	assert.InDeltaSlice(t, []float64{5, 3}, []float64(sigma), 1e-12)
+}
+
+func TestSVDRankDeficient(t *testing.T) {
+	sigma := assertSVD(t, seqoperations.Matrix[int]{{1, 2, 3}, {2, 4, 6}, {1, 1, 1}})
+	assert.InDelta(t, 0.0, sigma[2], 1e-12)
+}
+
+func TestSVDZeroMatrix(t *testing.T) {
+	sigma := assertSVD(t, seqoperations.NewZeroMatrix[int](2, 3))
+	assert.Equal(t, seqoperations.Vector[float64]{0, 0}, sigma)
+}
+
+func TestSVDErrors(t *testing.T) {
+	_, _, _, err := seqoperations.Matrix[int]{}.SVD()
+	assert.Equal(t, e.ErrZeroLength, err)
+
+	m := seqoperations.Matrix[float64]{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}
+	_, _, _, err = m.SVD(seqoperations.WithMaxIterations(1))
+	assert.Equal(t, e.ErrNoConvergence, err)
+}
+
+/*
+Test Rank, PseudoInverse and ConditionNumber
+*/
+
+func TestRank(t *testing.T) {
+	rank, err := seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}.Rank(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, rank)
+
+	rank, err = seqoperations.NewIdentityMatrix[int](4).Rank(0)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, rank)
+
+	rank, err = seqoperations.Matrix[float64]{{1, 0}, {0, 1e-6}}.Rank(1e-3)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, rank)
+
+	_, err = seqoperations.Matrix[int]{}.Rank(0)
+	assert.Equal(t, e.ErrZeroLength, err)
+}
+
+func TestPseudoInverseMatchesInverse(t *testing.T) {
+	m := seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}, {7, 8, 10}}
+	pinv, err := m.PseudoInverse()
+	assert.Nil(t, err)
+	inv, _ := m.Inverse()
+	assert.True(t, inv.WithinSigma(pinv, 1e-10))
+}
+
+func TestPseudoInverseSingularAndNonSquare(t *testing.T) {
+	for _, m := range []seqoperations.Matrix[int]{
+		{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}},
+		{{1, 2}, {3, 4}, {5, 6}},
+		{{1, 0, 2}, {0, 1, 1}},
+	} {
+		pinv, err := m.PseudoInverse()
+		assert.Nil(t, err)
+		rows, columns := m.Dimensions()
+		assert.True(t, pinv.SameDimensions(seqoperations.NewZeroMatrix[float64](columns, rows)))
+
+		// Moore-Penrose condition M * pinv * M == M
+		f := m.Float64Copy()
+		mp, _ := f.Multiply(pinv)
+		mpm, _ := mp.Multiply(f)
+		assert.True(t, f.WithinSigma(mpm, 1e-10))
+
+		// Moore-Penrose condition pinv * M * pinv == pinv
+		pm, _ := pinv.Multiply(f)
+		pmp, _ := pm.Multiply(pinv)
+		assert.True(t, pinv.WithinSigma(pmp, 1e-10))
+	}
+}
+
+func TestConditionNumber(t *testing.T) {
+	c, err := seqoperations.Matrix[int]{{2, 0}, {0, 8}}.ConditionNumber()
+	assert.Nil(t, err)
+	assert.InDelta(t, 4.0, c, 1e-12)
+
+	c, err = seqoperations.Matrix[int]{{1, 1}, {1, 1}}.ConditionNumber()
+	assert.Nil(t, err)
+	assert.Greater(t, c, 1e12)
+
+	_, err = seqoperations.Matrix[int]{}.ConditionNumber()
+	assert.Equal(t, e.ErrZeroLength, err)
+}
@@ -0,0 +1,412 @@
+package seqoperations
+
+// UpperTriangular is a square matrix that is zero below the main diagonal.
+// Only the elements on and above the diagonal are stored, packed row by row.
+type UpperTriangular[N Number] struct {
+	dimension int
+	data      []N
+}
+
+// LowerTriangular is a square matrix that is zero above the main diagonal.
+// Only the elements on and below the diagonal are stored, packed row by row.
+type LowerTriangular[N Number] struct {
+	dimension int
+	data      []N
+}
+
+// Diagonal is a square matrix that is zero away from the main diagonal, stored as a vector
+type Diagonal[N Number] struct {
+	diagonal Vector[N]
+}
+
+// NewUpperTriangular returns an n x n UpperTriangular with all elements zero
+func NewUpperTriangular[N Number](n int) UpperTriangular[N] {
+	if n <= 0 {
+		return UpperTriangular[N]{}
+	}
+	return UpperTriangular[N]{dimension: n, data: make([]N, n*(n+1)/2)}
+}
+
+// NewLowerTriangular returns an n x n LowerTriangular with all elements zero
+func NewLowerTriangular[N Number](n int) LowerTriangular[N] {
+	if n <= 0 {
+		return LowerTriangular[N]{}
+	}
+	return LowerTriangular[N]{dimension: n, data: make([]N, n*(n+1)/2)}
+}
+
+// NewDiagonal returns the diagonal matrix with the supplied elements on its main diagonal.
+// The vector is copied.
+func NewDiagonal[N Number](diagonal Vector[N]) Diagonal[N] {
+	return Diagonal[N]{diagonal: append(Vector[N]{}, diagonal...)}
+}
+
+// NewIdentityDiagonal returns the n x n identity matrix, storing only its n diagonal elements
+func NewIdentityDiagonal[N Number](n int) Diagonal[N] {
+	if n <= 0 {
+		return Diagonal[N]{}
+	}
+	diagonal := NewZeroVector[N](n)
+	for i := range diagonal {
+		diagonal[i] = 1
+	}
+	return Diagonal[N]{diagonal: diagonal}
+}
+
+// UpperTriangular returns the elements of a square matrix on and above the main diagonal.
+// Elements below the diagonal are ignored.
+// errNonSquare is returned if the matrix is not square and errRagged if it is ragged.
+func (m Matrix[N]) UpperTriangular() (UpperTriangular[N], error) {
+	if err := m.Validate(); err != nil {
+		return UpperTriangular[N]{}, err
+	}
+	if !m.IsSquare() {
+		return UpperTriangular[N]{}, errNonSquare
+	}
+	u := NewUpperTriangular[N](len(m))
+	for i := 0; i < u.dimension; i++ {
+		copy(u.data[u.index(i, i):], m[i][i:])
+	}
+	return u, nil
+}
+
+// LowerTriangular returns the elements of a square matrix on and below the main diagonal.
+// Elements above the diagonal are ignored.
+// errNonSquare is returned if the matrix is not square and errRagged if it is ragged.
+func (m Matrix[N]) LowerTriangular() (LowerTriangular[N], error) {
+	if err := m.Validate(); err != nil {
+		return LowerTriangular[N]{}, err
+	}
+	if !m.IsSquare() {
+		return LowerTriangular[N]{}, errNonSquare
+	}
+	l := NewLowerTriangular[N](len(m))
+	for i := 0; i < l.dimension; i++ {
+		copy(l.data[l.index(i, 0):], m[i][:i+1])
+	}
+	return l, nil
+}
+
+// Dimensions returns the number of rows and columns of u
+func (u UpperTriangular[N]) Dimensions() (int, int) {
+	return u.dimension, u.dimension
+}
+
+// At returns element (i, j) of u, which is zero below the diagonal.
+// At panics if i or j is out of range.
+func (u UpperTriangular[N]) At(i, j int) N {
+	checkSquareIndex(u.dimension, i, j)
+	if j < i {
+		return N(0)
+	}
+	return u.data[u.index(i, j)]
+}
+
+// Set sets element (i, j) of u to value. Set panics if (i, j) is outside the matrix or below the diagonal.
+func (u UpperTriangular[N]) Set(i, j int, value N) {
+	checkSquareIndex(u.dimension, i, j)
+	if j < i {
+		panic(errRowColSuppliedOutBounds)
+	}
+	u.data[u.index(i, j)] = value
+}
+
+// Matrix returns u as a Matrix with every element stored
+func (u UpperTriangular[N]) Matrix() Matrix[N] {
+	m := NewZeroMatrix[N](u.dimension, u.dimension)
+	for i := 0; i < u.dimension; i++ {
+		copy(m[i][i:], u.row(i))
+	}
+	return m
+}
+
+// Determinant returns the determinant of u, which is the product of its diagonal elements
+func (u UpperTriangular[N]) Determinant() float64 {
+	determinant := 1.0
+	for i := 0; i < u.dimension; i++ {
+		determinant *= float64(u.data[u.index(i, i)])
+	}
+	return determinant
+}
+
+// Solve returns the vector x satisfying U * x = b by back substitution.
+// errMultiplicationValidity is returned if the length of b does not match the dimension of u
+// and errNoInverse is returned if a diagonal element is zero.
+func (u UpperTriangular[N]) Solve(b Vector[N]) (Vector[float64], error) {
+	if err := checkTriangularSystem(u.dimension, len(b)); err != nil {
+		return Vector[float64]{}, err
+	}
+	x := make(Vector[float64], u.dimension)
+	for i := u.dimension - 1; i >= 0; i-- {
+		row := u.row(i)
+		if row[0] == 0 {
+			return Vector[float64]{}, errNoInverse
+		}
+		total := float64(b[i])
+		for k := 1; k < len(row); k++ {
+			total -= float64(row[k]) * x[i+k]
+		}
+		x[i] = total / float64(row[0])
+	}
+	return x, nil
+}
+
+// Multiply returns the matrix P = U * M, visiting only the stored elements of u.
+// errMultiplicationValidity is returned if the rows of M do not match the dimension of u.
+func (u UpperTriangular[N]) Multiply(m Matrix[N]) (Matrix[N], error) {
+	columns, err := checkStructuredProduct(u.dimension, m)
+	if err != nil {
+		return Matrix[N]{}, err
+	}
+	out := NewZeroMatrix[N](u.dimension, columns)
+	for i := 0; i < u.dimension; i++ {
+		for k, element := range u.row(i) {
+			addScaledRow(out[i], m[i+k], element)
+		}
+	}
+	return out, nil
+}
+
+// row returns the stored elements of row i of u, from the diagonal to the last column
+func (u UpperTriangular[N]) row(i int) []N {
+	start := u.index(i, i)
+	return u.data[start : start+u.dimension-i]
+}
+
+// index returns the position of element (i, j), which must be on or above the diagonal, in the data of u
+func (u UpperTriangular[N]) index(i, j int) int {
+	return i*u.dimension - i*(i-1)/2 + j - i
+}
+
+// Dimensions returns the number of rows and columns of l
+func (l LowerTriangular[N]) Dimensions() (int, int) {
+	return l.dimension, l.dimension
+}
+
+// At returns element (i, j) of l, which is zero above the diagonal.
+// At panics if i or j is out of range.
+func (l LowerTriangular[N]) At(i, j int) N {
+	checkSquareIndex(l.dimension, i, j)
+	if j > i {
+		return N(0)
+	}
+	return l.data[l.index(i, j)]
+}
+
+// Set sets element (i, j) of l to value. Set panics if (i, j) is outside the matrix or above the diagonal.
+func (l LowerTriangular[N]) Set(i, j int, value N) {
+	checkSquareIndex(l.dimension, i, j)
+	if j > i {
+		panic(errRowColSuppliedOutBounds)
+	}
+	l.data[l.index(i, j)] = value
+}
+
+// Matrix returns l as a Matrix with every element stored
+func (l LowerTriangular[N]) Matrix() Matrix[N] {
+	m := NewZeroMatrix[N](l.dimension, l.dimension)
+	for i := 0; i < l.dimension; i++ {
+		copy(m[i], l.row(i))
+	}
+	return m
+}
+
+// Determinant returns the determinant of l, which is the product of its diagonal elements
+func (l LowerTriangular[N]) Determinant() float64 {
+	determinant := 1.0
+	for i := 0; i < l.dimension; i++ {
+		determinant *= float64(l.data[l.index(i, i)])
+	}
+	return determinant
+}
+
+// Solve returns the vector x satisfying L * x = b by forward substitution.
+// errMultiplicationValidity is returned if the length of b does not match the dimension of l
+// and errNoInverse is returned if a diagonal element is zero.
+func (l LowerTriangular[N]) Solve(b Vector[N]) (Vector[float64], error) {
+	if err := checkTriangularSystem(l.dimension, len(b)); err != nil {
+		return Vector[float64]{}, err
+	}
+	x := make(Vector[float64], l.dimension)
+	for i := 0; i < l.dimension; i++ {
+		row := l.row(i)
+		if row[i] == 0 {
+			return Vector[float64]{}, errNoInverse
+		}
+		total := float64(b[i])
+		for k := 0; k < i; k++ {
+			total -= float64(row[k]) * x[k]
+		}
+		x[i] = total / float64(row[i])
+	}
+	return x, nil
+}
+
+// Multiply returns the matrix P = L * M, visiting only the stored elements of l.
+// errMultiplicationValidity is returned if the rows of M do not match the dimension of l.
+func (l LowerTriangular[N]) Multiply(m Matrix[N]) (Matrix[N], error) {
+	columns, err := checkStructuredProduct(l.dimension, m)
+	if err != nil {
+		return Matrix[N]{}, err
+	}
+	out := NewZeroMatrix[N](l.dimension, columns)
+	for i := 0; i < l.dimension; i++ {
+		for k, element := range l.row(i) {
+			addScaledRow(out[i], m[k], element)
+		}
+	}
+	return out, nil
+}
+
+// row returns the stored elements of row i of l, from the first column to the diagonal
+func (l LowerTriangular[N]) row(i int) []N {
+	start := l.index(i, 0)
+	return l.data[start : start+i+1]
+}
+
+// index returns the position of element (i, j), which must be on or below the diagonal, in the data of l
+func (l LowerTriangular[N]) index(i, j int) int {
+	return i*(i+1)/2 + j
+}
+
+// Dimensions returns the number of rows and columns of d
+func (d Diagonal[N]) Dimensions() (int, int) {
+	return len(d.diagonal), len(d.diagonal)
+}
+
+// At returns element (i, j) of d, which is zero away from the diagonal.
+// At panics if i or j is out of range.
+func (d Diagonal[N]) At(i, j int) N {
+	checkSquareIndex(len(d.diagonal), i, j)
+	if i != j {
+		return N(0)
+	}
+	return d.diagonal[i]
+}
+
+// Set sets element (i, i) of d to value. Set panics if i is out of range.
+func (d Diagonal[N]) Set(i int, value N) {
+	checkSquareIndex(len(d.diagonal), i, i)
+	d.diagonal[i] = value
+}
+
+// Matrix returns d as a Matrix with every element stored
+func (d Diagonal[N]) Matrix() Matrix[N] {
+	dimension := len(d.diagonal)
+	m := NewZeroMatrix[N](dimension, dimension)
+	for i, element := range d.diagonal {
+		m[i][i] = element
+	}
+	return m
+}
+
+// Determinant returns the determinant of d, which is the product of its diagonal elements
+func (d Diagonal[N]) Determinant() float64 {
+	determinant := 1.0
+	for _, element := range d.diagonal {
+		determinant *= float64(element)
+	}
+	return determinant
+}
+
+// Inverse returns the inverse of d in O(n) operations by taking the reciprocal of each diagonal element.
+// errNoInverse is returned if a diagonal element is zero.
+func (d Diagonal[N]) Inverse() (Diagonal[float64], error) {
+	inverse := make(Vector[float64], len(d.diagonal))
+	for i, element := range d.diagonal {
+		if element == 0 {
+			return Diagonal[float64]{}, errNoInverse
+		}
+		inverse[i] = 1.0 / float64(element)
+	}
+	return Diagonal[float64]{diagonal: inverse}, nil
+}
+
+// Solve returns the vector x satisfying D * x = b by dividing each element of b by the diagonal.
+// errMultiplicationValidity is returned if the length of b does not match the dimension of d
+// and errNoInverse is returned if a diagonal element is zero.
+func (d Diagonal[N]) Solve(b Vector[N]) (Vector[float64], error) {
+	if err := checkTriangularSystem(len(d.diagonal), len(b)); err != nil {
+		return Vector[float64]{}, err
+	}
+	x := make(Vector[float64], len(b))
+	for i, element := range d.diagonal {
+		if element == 0 {
+			return Vector[float64]{}, errNoInverse
+		}
+		x[i] = float64(b[i]) / float64(element)
+	}
+	return x, nil
+}
+
+// Multiply returns the matrix P = D * M, which scales row i of M by element i of the diagonal.
+// errMultiplicationValidity is returned if the rows of M do not match the dimension of d.
+func (d Diagonal[N]) Multiply(m Matrix[N]) (Matrix[N], error) {
+	columns, err := checkStructuredProduct(len(d.diagonal), m)
+	if err != nil {
+		return Matrix[N]{}, err
+	}
+	out := NewZeroMatrix[N](len(d.diagonal), columns)
+	for i, element := range d.diagonal {
+		addScaledRow(out[i], m[i], element)
+	}
+	return out, nil
+}
+
+// MultiplyRight returns the matrix P = M * D, which scales column j of M by element j of the diagonal.
+// errMultiplicationValidity is returned if the columns of M do not match the dimension of d.
+func (d Diagonal[N]) MultiplyRight(m Matrix[N]) (Matrix[N], error) {
+	if err := m.Validate(); err != nil {
+		return Matrix[N]{}, err
+	}
+	rows, columns := m.Dimensions()
+	if columns != len(d.diagonal) {
+		return Matrix[N]{}, errMultiplicationValidity
+	}
+	out := NewZeroMatrix[N](rows, columns)
+	for i, row := range m {
+		for j, element := range row {
+			out[i][j] = element * d.diagonal[j]
+		}
+	}
+	return out, nil
+}
+
+// checkSquareIndex panics if (i, j) is not an element of a square matrix of the supplied dimension
+func checkSquareIndex(dimension, i, j int) {
+	if i < 0 || i >= dimension || j < 0 || j >= dimension {
+		panic(errRowColSuppliedOutBounds)
+	}
+}
+
+// checkTriangularSystem returns an error if a system of the supplied dimension cannot be solved
+// for a right hand side of length rhs
+func checkTriangularSystem(dimension, rhs int) error {
+	if dimension == 0 {
+		return errZeroLength
+	}
+	if rhs != dimension {
+		return errMultiplicationValidity
+	}
+	return nil
+}
+
+// checkStructuredProduct returns the columns of m if it can be multiplied on the left by a square
+// matrix of the supplied dimension
+func checkStructuredProduct[N Number](dimension int, m Matrix[N]) (int, error) {
+	if err := m.Validate(); err != nil {
+		return 0, err
+	}
+	rows, columns := m.Dimensions()
+	if rows != dimension {
+		return 0, errMultiplicationValidity
+	}
+	return columns, nil
+}
+
+// addScaledRow adds scale * row to out
+func addScaledRow[N Number](out, row []N, scale N) {
+	for j, element := range row {
+		out[j] += scale * element
+	}
+}
@@ -0,0 +1,146 @@
+package seqoperations_test
+
+import (
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+var triangularSource = seqoperations.Matrix[int]{
+	{2, 1, -1, 3},
+	{4, -3, 2, 1},
+	{5, 1, 4, -2},
+	{-1, 6, 2, 5},
+}
+
+/*
+Test UpperTriangular
+*/
+
+func TestUpperTriangular(t *testing.T) {
+	u, err := triangularSource.UpperTriangular()
+	assert.Nil(t, err)
+	expected := seqoperations.Matrix[int]{
+		{2, 1, -1, 3},
+		{0, -3, 2, 1},
+		{0, 0, 4, -2},
+		{0, 0, 0, 5},
+	}
+	assert.Equal(t, expected, u.Matrix())
+	assert.Equal(t, 0, u.At(3, 1))
+	assert.Equal(t, -2, u.At(2, 3))
+	assert.Equal(t, -120.0, u.Determinant())
+	determinant, _ := expected.Determinant()
+	assert.InDelta(t, determinant, u.Determinant(), 1e-9)
+
+	product, err := u.Multiply(triangularSource)
+	assert.Nil(t, err)
+	dense, _ := expected.Multiply(triangularSource)
+	assert.Equal(t, dense, product)
+
+	b := seqoperations.Vector[int]{3, -1, 4, 10}
+	x, err := u.Solve(b)
+	assert.Nil(t, err)
+	assertSolves(t, expected.Float64Copy(), x, seqoperations.Vector[float64]{3, -1, 4, 10}, 1e-12)
+
+	u.Set(1, 1, 0)
+	_, err = u.Solve(b)
+	assert.Equal(t, e.ErrNoInverse, err)
+	assert.Panics(t, func() { u.Set(2, 1, 1) })
+	assert.Panics(t, func() { u.At(4, 4) })
+}
+
+/*
+Test LowerTriangular
+*/
+
+func TestLowerTriangular(t *testing.T) {
+	l, err := triangularSource.LowerTriangular()
+	assert.Nil(t, err)
+	expected := seqoperations.Matrix[int]{
+		{2, 0, 0, 0},
+		{4, -3, 0, 0},
+		{5, 1, 4, 0},
+		{-1, 6, 2, 5},
+	}
+	assert.Equal(t, expected, l.Matrix())
+	assert.Equal(t, 0, l.At(1, 3))
+	assert.Equal(t, 6, l.At(3, 1))
+	assert.Equal(t, -120.0, l.Determinant())
+
+	product, err := l.Multiply(triangularSource)
+	assert.Nil(t, err)
+	dense, _ := expected.Multiply(triangularSource)
+	assert.Equal(t, dense, product)
+
+	b := seqoperations.Vector[int]{3, -1, 4, 10}
+	x, err := l.Solve(b)
+	assert.Nil(t, err)
+	assertSolves(t, expected.Float64Copy(), x, seqoperations.Vector[float64]{3, -1, 4, 10}, 1e-12)
+
+	_, err = l.Solve(b[:2])
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+	_, err = l.Multiply(triangularSource[:3])
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+	assert.Panics(t, func() { l.Set(1, 2, 1) })
+
+	_, err = seqoperations.Matrix[int]{{1, 2, 3}, {4, 5, 6}}.LowerTriangular()
+	assert.Equal(t, e.ErrNonSquare, err)
+	_, err = seqoperations.Matrix[int]{{1, 2}, {4}}.UpperTriangular()
+	assert.Equal(t, e.ErrRagged, err)
+	_, err = seqoperations.NewLowerTriangular[int](0).Solve(seqoperations.Vector[int]{})
+	assert.Equal(t, e.ErrZeroLength, err)
+}
+
+/*
+Test Diagonal
+*/
+
+func TestDiagonal(t *testing.T) {
+	d := seqoperations.NewDiagonal(seqoperations.Vector[int]{2, -4, 5, 1})
+	expected := seqoperations.Matrix[int]{
+		{2, 0, 0, 0},
+		{0, -4, 0, 0},
+		{0, 0, 5, 0},
+		{0, 0, 0, 1},
+	}
+	assert.Equal(t, expected, d.Matrix())
+	assert.Equal(t, -40.0, d.Determinant())
+	assert.Equal(t, 0, d.At(0, 1))
+
+	product, err := d.Multiply(triangularSource)
+	assert.Nil(t, err)
+	dense, _ := expected.Multiply(triangularSource)
+	assert.Equal(t, dense, product)
+
+	wide := seqoperations.Matrix[int]{{1, 2, 3, 4}, {-1, 0, 2, 3}}
+	product, err = d.MultiplyRight(wide)
+	assert.Nil(t, err)
+	dense, _ = wide.Multiply(expected)
+	assert.Equal(t, dense, product)
+	_, err = d.MultiplyRight(seqoperations.Matrix[int]{{1, 2, 3}})
+	assert.Equal(t, e.ErrMultiplicationValidity, err)
+	_, err = d.MultiplyRight(seqoperations.Matrix[int]{{1, 2, 3, 4}, {1}})
+	assert.Equal(t, e.ErrRagged, err)
+
+	inverse, err := d.Inverse()
+	assert.Nil(t, err)
+	denseInverse, _ := expected.Inverse()
+	assert.Equal(t, denseInverse, inverse.Matrix())
+
+	x, err := d.Solve(seqoperations.Vector[int]{4, 2, 5, -3})
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[float64]{2, -0.5, 1, -3}, x)
+
+	d.Set(2, 0)
+	_, err = d.Inverse()
+	assert.Equal(t, e.ErrNoInverse, err)
+	_, err = d.Solve(seqoperations.Vector[int]{1, 1, 1, 1})
+	assert.Equal(t, e.ErrNoInverse, err)
+
+	identity := seqoperations.NewIdentityDiagonal[float64](5)
+	assert.Equal(t, seqoperations.NewIdentityMatrix[float64](5), identity.Matrix())
+	assert.Equal(t, 1.0, identity.Determinant())
+}
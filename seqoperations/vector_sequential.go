@@ -1,5 +1,7 @@
 package seqoperations
 
+import "math"
+
 // DotProduct returns dot product and true if vectors are same length, 0 and false otherwise
 func (v Vector[N]) DotProduct(u Vector[N]) (N, bool) {
 	length := len(v)
@@ -53,3 +55,105 @@ func (v Vector[N]) DivideElementsBy(x N) Vector[N] {
 func (v Vector[N]) DivideByElements(x N) Vector[N] {
 	return v.MapFunctionToElements(func(element N) N { return x / element })
 }
+
+// ApplyOneToOne requires two vectors of same length.
+// takes fn: a function that is applied element wise to each element Vi and Ui.
+// in the resulting vector : Wi = fn(Vi, Ui)
+func (v Vector[N]) ApplyOneToOne(u Vector[N], fn OneToOneSequentialOperater[N]) (Vector[N], error) {
+	length := len(v)
+	if length != len(u) {
+		return nil, errDifferentDimension
+	}
+	w := NewZeroVector[N](length)
+	for k := 0; k < length; k++ {
+		w[k] = fn(v[k], u[k])
+	}
+	return w, nil
+}
+
+// AddVectors returns Vector W such that Wi = Vi + Ui if the vectors are the same length
+func (v Vector[N]) AddVectors(u Vector[N]) (Vector[N], error) {
+	return v.ApplyOneToOne(u, Add[N])
+}
+
+// SubtractVectors returns Vector W such that Wi = Vi - Ui if the vectors are the same length
+func (v Vector[N]) SubtractVectors(u Vector[N]) (Vector[N], error) {
+	return v.ApplyOneToOne(u, Subtract[N])
+}
+
+// ElementWiseMultiply returns Vector W such that Wi = Vi x Ui if the vectors are the same length
+func (v Vector[N]) ElementWiseMultiply(u Vector[N]) (Vector[N], error) {
+	return v.ApplyOneToOne(u, Multiply[N])
+}
+
+// CrossProduct returns the vector perpendicular to v and u following the right hand rule,
+// with length |v||u|sin(theta). errNotThreeDimensional is returned unless both vectors have length 3.
+func (v Vector[N]) CrossProduct(u Vector[N]) (Vector[N], error) {
+	if len(v) != 3 || len(u) != 3 {
+		return nil, errNotThreeDimensional
+	}
+	return Vector[N]{
+		v[1]*u[2] - v[2]*u[1],
+		v[2]*u[0] - v[0]*u[2],
+		v[0]*u[1] - v[1]*u[0],
+	}, nil
+}
+
+// OuterProduct returns the len(v) x len(u) matrix P where Pij = Vi x Uj
+func (v Vector[N]) OuterProduct(u Vector[N]) Matrix[N] {
+	p := NewZeroMatrix[N](len(v), len(u))
+	for i := range v {
+		for j := range u {
+			p[i][j] = v[i] * u[j]
+		}
+	}
+	return p
+}
+
+// Normalize returns the unit vector pointing in the same direction as v.
+// errZeroNorm is returned if v is zero, as its direction is undefined.
+func (v Vector[N]) Normalize() (Vector[float64], error) {
+	norm := v.NormL2()
+	if norm == 0.0 {
+		return nil, errZeroNorm
+	}
+	unit := NewZeroVector[float64](len(v))
+	for k := range v {
+		unit[k] = float64(v[k]) / norm
+	}
+	return unit, nil
+}
+
+// ProjectOnto returns the component of v that lies along u, (v.u / u.u) u.
+// errDifferentDimension is returned if the vectors are not the same length and errZeroNorm if u is zero.
+func (v Vector[N]) ProjectOnto(u Vector[N]) (Vector[float64], error) {
+	if len(v) != len(u) {
+		return nil, errDifferentDimension
+	}
+	unit, err := u.Normalize()
+	if err != nil {
+		return nil, err
+	}
+	// projecting onto the unit vector avoids squaring the elements of u, which could overflow
+	length := 0.0
+	for k := range v {
+		length += float64(v[k]) * unit[k]
+	}
+	for k := range unit {
+		unit[k] *= length
+	}
+	return unit, nil
+}
+
+// Angle returns the angle in radians, from 0 to pi, between v and u.
+// The angle is found as 2 * atan2(|v/|v| - u/|u||, |v/|v| + u/|u||), which unlike the arc cosine of
+// the cosine stays accurate for nearly parallel and nearly opposite vectors.
+// errDifferentDimension is returned if the vectors are not the same length and errZeroNorm if either
+// vector is zero.
+func (v Vector[N]) Angle(u Vector[N]) (float64, error) {
+	difference, sum, err := v.unitDistances(u)
+	if err != nil {
+		return 0.0, err
+	}
+	return 2.0 * math.Atan2(difference, sum), nil
+}
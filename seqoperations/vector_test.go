@@ -0,0 +1,155 @@
+package seqoperations_test
+
+import (
+	"math"
+	"testing"
+
+	e "github.com/DominicHinton/matrix/errors"
+	"github.com/DominicHinton/matrix/seqoperations"
+	"github.com/stretchr/testify/assert"
+)
+
+/*
+Test element wise vector operations
+*/
+
+func TestVectorElementWise(t *testing.T) {
+	v := seqoperations.Vector[int]{1, 2, 3}
+	u := seqoperations.Vector[int]{4, 5, 6}
+
+	sum, err := v.AddVectors(u)
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[int]{5, 7, 9}, sum)
+	difference, err := v.SubtractVectors(u)
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[int]{-3, -3, -3}, difference)
+	product, err := v.ElementWiseMultiply(u)
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[int]{4, 10, 18}, product)
+	assert.Equal(t, seqoperations.Vector[int]{1, 2, 3}, v)
+
+	_, err = v.AddVectors(u[:2])
+	assert.Equal(t, e.ErrDifferentDimension, err)
+	_, err = v.SubtractVectors(u[:2])
+	assert.Equal(t, e.ErrDifferentDimension, err)
+	_, err = v.ElementWiseMultiply(u[:2])
+	assert.Equal(t, e.ErrDifferentDimension, err)
+
+	empty, err := seqoperations.Vector[int]{}.AddVectors(seqoperations.Vector[int]{})
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(empty))
+}
+
+/*
+Test cross and outer products
+*/
+
+func TestCrossProduct(t *testing.T) {
+	x := seqoperations.Vector[int]{1, 0, 0}
+	y := seqoperations.Vector[int]{0, 1, 0}
+	z, err := x.CrossProduct(y)
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[int]{0, 0, 1}, z)
+	z, _ = y.CrossProduct(x)
+	assert.Equal(t, seqoperations.Vector[int]{0, 0, -1}, z)
+
+	v := seqoperations.Vector[float64]{2, 3, 4}
+	u := seqoperations.Vector[float64]{5, 6, 7}
+	w, err := v.CrossProduct(u)
+	assert.Nil(t, err)
+	assert.Equal(t, seqoperations.Vector[float64]{-3, 6, -3}, w)
+	// the cross product is perpendicular to both inputs
+	dot, _ := w.DotProduct(v)
+	assert.Equal(t, 0.0, dot)
+	dot, _ = w.DotProduct(u)
+	assert.Equal(t, 0.0, dot)
+
+	_, err = seqoperations.Vector[int]{1, 2}.CrossProduct(seqoperations.Vector[int]{3, 4})
+	assert.Equal(t, e.ErrNotThreeDimensional, err)
+	_, err = x.CrossProduct(seqoperations.Vector[int]{1, 2, 3, 4})
+	assert.Equal(t, e.ErrNotThreeDimensional, err)
+}
+
+func TestOuterProduct(t *testing.T) {
+	v := seqoperations.Vector[int]{1, 2, 3}
+	u := seqoperations.Vector[int]{4, 5}
+	assert.Equal(t, seqoperations.Matrix[int]{{4, 5}, {8, 10}, {12, 15}}, v.OuterProduct(u))
+	assert.Equal(t, seqoperations.Matrix[int]{{4, 8, 12}, {5, 10, 15}}, u.OuterProduct(v))
+
+	i, j := v.OuterProduct(seqoperations.Vector[int]{}).Dimensions()
+	assert.Equal(t, 3, i)
+	assert.Equal(t, 0, j)
+}
+
+/*
+Test normalisation, projection and angles
+*/
+
+func TestNormalize(t *testing.T) {
+	unit, err := seqoperations.Vector[int]{3, 0, -4}.Normalize()
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{0.6, 0, -0.8}, unit, 1e-15)
+
+	unit, err = seqoperations.Vector[float64]{3e200, 4e200}.Normalize()
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{0.6, 0.8}, unit, 1e-15)
+
+	_, err = seqoperations.Vector[int]{0, 0}.Normalize()
+	assert.Equal(t, e.ErrZeroNorm, err)
+	_, err = seqoperations.Vector[int]{}.Normalize()
+	assert.Equal(t, e.ErrZeroNorm, err)
+}
+
+func TestProjectOnto(t *testing.T) {
+	v := seqoperations.Vector[int]{2, 3}
+	projection, err := v.ProjectOnto(seqoperations.Vector[int]{5, 0})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{2, 0}, projection, 1e-15)
+
+	projection, err = v.ProjectOnto(seqoperations.Vector[int]{1, 1})
+	assert.Nil(t, err)
+	assert.InDeltaSlice(t, []float64{2.5, 2.5}, projection, 1e-14)
+
+	// the remainder after projecting is perpendicular to the direction projected onto
+	a := seqoperations.Vector[float64]{1.5, -2, 7}
+	b := seqoperations.Vector[float64]{-3, 0.25, 2}
+	projection, _ = a.ProjectOnto(b)
+	remainder, _ := a.SubtractVectors(projection)
+	dot, _ := remainder.DotProduct(b)
+	assert.InDelta(t, 0.0, dot, 1e-14)
+
+	_, err = v.ProjectOnto(seqoperations.Vector[int]{1, 2, 3})
+	assert.Equal(t, e.ErrDifferentDimension, err)
+	_, err = v.ProjectOnto(seqoperations.Vector[int]{0, 0})
+	assert.Equal(t, e.ErrZeroNorm, err)
+}
+
+func TestAngle(t *testing.T) {
+	x := seqoperations.Vector[float64]{1, 0}
+	for expected, u := range map[float64]seqoperations.Vector[float64]{
+		0:               {4, 0},
+		math.Pi / 4:     {1, 1},
+		math.Pi / 2:     {0, -2},
+		3 * math.Pi / 4: {-1, 1},
+		math.Pi:         {-3, 0},
+	} {
+		angle, err := x.Angle(u)
+		assert.Nil(t, err)
+		assert.InDelta(t, expected, angle, 1e-15)
+	}
+
+	// the arc cosine of a cosine this close to one would round the angle to zero
+	for _, angle := range []float64{1e-9, 1e-15} {
+		small, err := x.Angle(seqoperations.Vector[float64]{1, angle})
+		assert.Nil(t, err)
+		assert.InDelta(t, angle, small, 1e-12*angle)
+		nearlyOpposite, err := x.Angle(seqoperations.Vector[float64]{-1, angle})
+		assert.Nil(t, err)
+		assert.InDelta(t, math.Pi-angle, nearlyOpposite, 1e-15)
+	}
+
+	_, err := x.Angle(seqoperations.Vector[float64]{1, 0, 0})
+	assert.Equal(t, e.ErrDifferentDimension, err)
+	_, err = x.Angle(seqoperations.Vector[float64]{0, 0})
+	assert.Equal(t, e.ErrZeroNorm, err)
+}